@@ -0,0 +1,541 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ersBulkRequest is the ERS bulk envelope. Unlike the SDK request structs it
+// also carries the objects the operation applies to.
+type ersBulkRequest struct {
+	OperationType     string        `json:"operationType,omitempty"`
+	ResourceMediaType string        `json:"resourceMediaType,omitempty"`
+	ResourcesList     []interface{} `json:"resourcesList,omitempty"`
+	IDList            []string      `json:"idList,omitempty"`
+}
+
+type ersBulkStatus struct {
+	BulkID          string                  `json:"bulkId,omitempty"`
+	MediaType       string                  `json:"mediaType,omitempty"`
+	ExecutionStatus string                  `json:"executionStatus,omitempty"`
+	OperationType   string                  `json:"operationType,omitempty"`
+	StartTime       string                  `json:"startTime,omitempty"`
+	ResourcesCount  *int                    `json:"resourcesCount,omitempty"`
+	SuccessCount    *int                    `json:"successCount,omitempty"`
+	FailCount       *int                    `json:"failCount,omitempty"`
+	ResourcesStatus []ersBulkResourceStatus `json:"resourcesStatus,omitempty"`
+}
+
+type ersBulkResourceStatus struct {
+	ID                      string `json:"id,omitempty"`
+	Name                    string `json:"name,omitempty"`
+	Description             string `json:"description,omitempty"`
+	ResourceExecutionStatus string `json:"resourceExecutionStatus,omitempty"`
+	Status                  string `json:"status,omitempty"`
+}
+
+// ersBulkMonitorFunc wraps one of the SDK MonitorBulkStatus* calls.
+type ersBulkMonitorFunc func(bulkID string) (interface{}, *resty.Response, error)
+
+// ersBulkExpandFunc wraps one of the resource expandRequest* functions.
+type ersBulkExpandFunc func(ctx context.Context, key string, d *schema.ResourceData) interface{}
+
+var ersBulkOperationTypes = []string{"create", "update", "delete"}
+
+func isERSBulkStatusDone(executionStatus string) bool {
+	switch strings.ToUpper(executionStatus) {
+	case "COMPLETED", "ABORTED", "FAILED":
+		return true
+	}
+	return false
+}
+
+func (s *ersBulkStatus) failCount() int {
+	if s.FailCount != nil {
+		return *s.FailCount
+	}
+	count := 0
+	for _, item := range s.ResourcesStatus {
+		if strings.EqualFold(item.Status, "FAIL") || strings.EqualFold(item.Status, "FAILED") {
+			count++
+		}
+	}
+	return count
+}
+
+// resourceBulkRequestSchema returns the schema shared by the *_bulk_request
+// resources. resourcesElem is the parameters block of the matching single
+// object resource, so each entry of resources accepts the same attributes.
+func resourceBulkRequestSchema(resourcesElem *schema.Resource, operationTypes []string) map[string]*schema.Schema {
+	operationTypeSchema := &schema.Schema{
+		Description: `Operation to perform over the listed resources.`,
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+	}
+	if len(operationTypes) > 0 {
+		operationTypeSchema.Description = fmt.Sprintf("Operation to perform over the listed resources. Allowed values: %s.", listNicely(operationTypes))
+		operationTypeSchema.ValidateFunc = validateStringHasValueFunc(operationTypes)
+	}
+	return map[string]*schema.Schema{
+		"last_updated": &schema.Schema{
+			Description: `Unix timestamp records the last time that the resource was updated.`,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"item": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"bulk_id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"execution_status": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"fail_count": &schema.Schema{
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"media_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"operation_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"resources_count": &schema.Schema{
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"resources_status": &schema.Schema{
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"description": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"id": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"name": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"resource_execution_status": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"status": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+					"start_time": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"success_count": &schema.Schema{
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"parameters": &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			MinItems: 1,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"operation_type": operationTypeSchema,
+					"resource_media_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+					"resources": &schema.Schema{
+						Description: `Objects sent in the bulk request. For delete operations only the id of each object is used.`,
+						Type:        schema.TypeList,
+						Optional:    true,
+						ForceNew:    true,
+						Elem:        resourcesElem,
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceBulkRequestParametersElem returns the parameters block of a single
+// object resource, to be reused by the matching bulk request resource. The id
+// is made configurable since update and delete operations need it. The
+// attributes in exclude, which the bulk requests do not support, are left out.
+func resourceBulkRequestParametersElem(r *schema.Resource, exclude ...string) *schema.Resource {
+	elem := r.Schema["parameters"].Elem.(*schema.Resource)
+	resourceSchema := make(map[string]*schema.Schema, len(elem.Schema))
+	for k, v := range elem.Schema {
+		resourceSchema[k] = v
+	}
+	for _, k := range exclude {
+		delete(resourceSchema, k)
+	}
+	resourceSchema["id"] = &schema.Schema{
+		Description: `Resource UUID, required for update and delete operations.`,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	return &schema.Resource{
+		Schema: resourceSchema,
+	}
+}
+
+// resourceBulkRequestResourceV0 is the schema used by the bulk request
+// resources before resources and the structured item were added.
+func resourceBulkRequestResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"item": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				MinItems: 1,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"resource_media_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBulkRequestStateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourceBulkRequestResourceV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceBulkRequestStateUpgradeV0,
+		},
+	}
+}
+
+// resourceBulkRequestStateUpgradeV0 drops the raw response string previously
+// stored in item, it does not fit the structured item block.
+func resourceBulkRequestStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	if _, ok := rawState["item"].(string); ok {
+		delete(rawState, "item")
+	}
+	return rawState, nil
+}
+
+func expandRequestBulkRequestResources(ctx context.Context, key string, d *schema.ResourceData, expand ersBulkExpandFunc) []interface{} {
+	request := []interface{}{}
+	o := d.Get(fixKeyAccess(key))
+	if o == nil {
+		return nil
+	}
+	objs, ok := o.([]interface{})
+	if !ok {
+		return nil
+	}
+	for item_no := range objs {
+		i := expand(ctx, fmt.Sprintf("%s.%d", key, item_no), d)
+		if isEmptyValue(reflect.ValueOf(i)) {
+			continue
+		}
+		request = append(request, i)
+	}
+	if len(request) == 0 {
+		return nil
+	}
+	return request
+}
+
+func expandRequestBulkRequestResourceIDs(ctx context.Context, key string, d *schema.ResourceData) []string {
+	request := []string{}
+	o := d.Get(fixKeyAccess(key))
+	if o == nil {
+		return nil
+	}
+	objs, ok := o.([]interface{})
+	if !ok {
+		return nil
+	}
+	for item_no := range objs {
+		if v, ok := d.GetOk(fixKeyAccess(fmt.Sprintf("%s.%d.id", key, item_no))); ok {
+			request = append(request, interfaceToString(v))
+		}
+	}
+	if len(request) == 0 {
+		return nil
+	}
+	return request
+}
+
+// expandRequestBulkRequest builds the bulk envelope, expanding resources with
+// create for create operations, update for update operations and collecting
+// the ids for delete operations.
+func expandRequestBulkRequest(ctx context.Context, key string, d *schema.ResourceData, create ersBulkExpandFunc, update ersBulkExpandFunc) *ersBulkRequest {
+	request := ersBulkRequest{}
+	if v, ok := d.GetOkExists(fixKeyAccess(key + ".operation_type")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".operation_type")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".operation_type")))) {
+		request.OperationType = interfaceToString(v)
+	}
+	if v, ok := d.GetOkExists(fixKeyAccess(key + ".resource_media_type")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".resource_media_type")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".resource_media_type")))) {
+		request.ResourceMediaType = interfaceToString(v)
+	}
+	switch strings.ToLower(request.OperationType) {
+	case "delete":
+		request.IDList = expandRequestBulkRequestResourceIDs(ctx, key+".resources", d)
+	case "update":
+		if update == nil {
+			update = create
+		}
+		request.ResourcesList = expandRequestBulkRequestResources(ctx, key+".resources", d, update)
+	default:
+		request.ResourcesList = expandRequestBulkRequestResources(ctx, key+".resources", d, create)
+	}
+	return &request
+}
+
+// getServiceURL returns the absolute URL of path on the given ISE module
+// (_ers, _ui, _mnt, _px_grid), using the same ports as the SDK.
+func getServiceURL(clientConfig ClientConfig, module string, path string) string {
+	port := ""
+	if !clientConfig.UseAPIGateway {
+		switch module {
+		case "_ui", "_mnt":
+			port = ":443"
+		case "_ers":
+			port = ":9060"
+		case "_px_grid":
+			port = ":8910"
+		}
+	}
+	return strings.TrimSuffix(clientConfig.BaseURL, "/") + port + path
+}
+
+// submitBulkRequest sends the bulk envelope and returns the bulk id taken
+// from the Location header of the response.
+func submitBulkRequest(clientConfig ClientConfig, path string, envelope string, request *ersBulkRequest) (string, *resty.Response, error) {
+	body := map[string]interface{}{envelope: request}
+	response, err := clientConfig.Client.RestyClient().R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
+		SetBody(body).
+		Put(getServiceURL(clientConfig, "_ers", path))
+	if err != nil {
+		return "", response, err
+	}
+	if response.IsError() {
+		return "", response, fmt.Errorf("error with operation %s", envelope)
+	}
+	bulkID := ""
+	if locationHeader, ok := response.Header()["Location"]; ok && len(locationHeader) > 0 {
+		bulkID = getLocationID(locationHeader[0])
+	}
+	if bulkID == "" {
+		return "", response, fmt.Errorf("%s response did not include a bulk id", envelope)
+	}
+	return bulkID, response, nil
+}
+
+// waitBulkRequest polls the bulk monitor status endpoint until the job is
+// done or the timeout expires.
+func waitBulkRequest(ctx context.Context, timeout time.Duration, bulkID string, monitor ersBulkMonitorFunc) (*ersBulkStatus, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, restyResp, err := getBulkRequestStatus(bulkID, monitor)
+		if err != nil {
+			if restyResp != nil {
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp.String()))
+			}
+			log.Printf("[DEBUG] Bulk request %s status error: %s", bulkID, err.Error())
+		} else {
+			log.Printf("[DEBUG] Bulk request %s execution status %s", bulkID, status.ExecutionStatus)
+			if isERSBulkStatusDone(status.ExecutionStatus) {
+				return status, nil
+			}
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return nil, fmt.Errorf("timeout waiting for bulk request %s: %s", bulkID, err.Error())
+			}
+			return status, fmt.Errorf("timeout waiting for bulk request %s, last execution status %s", bulkID, status.ExecutionStatus)
+		}
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-time.After(BULK_REQUEST_STATUS_SLEEP):
+		}
+	}
+}
+
+func getBulkRequestStatus(bulkID string, monitor ersBulkMonitorFunc) (*ersBulkStatus, *resty.Response, error) {
+	response, restyResp, err := monitor(bulkID)
+	if err != nil {
+		return nil, restyResp, err
+	}
+	if isEmptyValue(reflect.ValueOf(response)) {
+		return nil, restyResp, fmt.Errorf("empty bulk status response")
+	}
+	b, err := json.Marshal(response)
+	if err != nil {
+		return nil, restyResp, err
+	}
+	result := struct {
+		BulkStatus *ersBulkStatus `json:"BulkStatus,omitempty"`
+	}{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, restyResp, err
+	}
+	if result.BulkStatus == nil {
+		return nil, restyResp, fmt.Errorf("bulk status response did not include BulkStatus")
+	}
+	return result.BulkStatus, restyResp, nil
+}
+
+func flattenBulkRequestStatus(item *ersBulkStatus) []map[string]interface{} {
+	if item == nil {
+		return nil
+	}
+	respItem := make(map[string]interface{})
+	respItem["bulk_id"] = item.BulkID
+	respItem["media_type"] = item.MediaType
+	respItem["execution_status"] = item.ExecutionStatus
+	respItem["operation_type"] = item.OperationType
+	respItem["start_time"] = item.StartTime
+	respItem["resources_count"] = item.ResourcesCount
+	respItem["success_count"] = item.SuccessCount
+	respItem["fail_count"] = item.FailCount
+	respItem["resources_status"] = flattenBulkRequestResourcesStatus(item.ResourcesStatus)
+	return []map[string]interface{}{
+		respItem,
+	}
+}
+
+func flattenBulkRequestResourcesStatus(items []ersBulkResourceStatus) []map[string]interface{} {
+	var respItems []map[string]interface{}
+	for _, item := range items {
+		respItem := make(map[string]interface{})
+		respItem["id"] = item.ID
+		respItem["name"] = item.Name
+		respItem["description"] = item.Description
+		respItem["resource_execution_status"] = item.ResourceExecutionStatus
+		respItem["status"] = item.Status
+		respItems = append(respItems, respItem)
+	}
+	return respItems
+}
+
+// executeBulkRequest submits the request, waits for the job and stores the
+// final status in item. It fails when any of the objects failed.
+func executeBulkRequest(ctx context.Context, d *schema.ResourceData, m interface{}, operation string, path string, envelope string, request *ersBulkRequest, monitor ersBulkMonitorFunc) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	bulkID, status, diags := runBulkRequest(ctx, clientConfig, d.Timeout(schema.TimeoutCreate), operation, path, envelope, request, monitor)
+	if bulkID != "" {
+		d.SetId(bulkID)
+		_ = d.Set("last_updated", getUnixTimeString())
+	}
+	if status != nil {
+		if err := d.Set("item", flattenBulkRequestStatus(status)); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting "+operation+" response",
+				err))
+			return diags
+		}
+	}
+	return diags
+}
+
+// runBulkRequest submits the request and waits for the job. It returns the
+// bulk id once submitted and the last status read, and fails when the job
+// does not finish or any of the objects failed.
+func runBulkRequest(ctx context.Context, clientConfig ClientConfig, timeout time.Duration, operation string, path string, envelope string, request *ersBulkRequest, monitor ersBulkMonitorFunc) (string, *ersBulkStatus, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if request != nil {
+		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request))
+	}
+	bulkID, restyResp1, err := submitBulkRequest(clientConfig, path, envelope, request)
+	if err != nil {
+		if restyResp1 != nil {
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
+			diags = append(diags, diagErrorWithResponse(
+				"Failure when executing "+operation, err, restyResp1.String()))
+			return "", nil, diags
+		}
+		diags = append(diags, diagError(
+			"Failure when executing "+operation, err))
+		return "", nil, diags
+	}
+	log.Printf("[DEBUG] %s submitted with bulk id %s", operation, bulkID)
+
+	status, err := waitBulkRequest(ctx, timeout, bulkID, monitor)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for "+operation, err))
+		return bulkID, status, diags
+	}
+	if failCount := status.failCount(); failCount > 0 {
+		failed := []string{}
+		for _, item := range status.ResourcesStatus {
+			if strings.EqualFold(item.Status, "SUCCESS") {
+				continue
+			}
+			name := item.Name
+			if name == "" {
+				name = item.ID
+			}
+			failed = append(failed, fmt.Sprintf("%s: %s", name, item.Description))
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s finished with %d failed resources", operation, failCount),
+			Detail:   strings.Join(failed, "\n"),
+		})
+		return bulkID, status, diags
+	}
+	return bulkID, status, diags
+}
@@ -0,0 +1,166 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+func TestBulkRequestGetStatus(t *testing.T) {
+	failCount := 1
+	successCount := 1
+	monitor := func(bulkID string) (interface{}, *resty.Response, error) {
+		return &isegosdk.ResponseNetworkDeviceMonitorBulkStatusNetworkDevice{
+			BulkStatus: &isegosdk.ResponseNetworkDeviceMonitorBulkStatusNetworkDeviceBulkStatus{
+				BulkID:          bulkID,
+				ExecutionStatus: "COMPLETED",
+				SuccessCount:    &successCount,
+				FailCount:       &failCount,
+				ResourcesStatus: &[]isegosdk.ResponseNetworkDeviceMonitorBulkStatusNetworkDeviceBulkStatusResourcesStatus{
+					{ID: "1", Name: "switch-01", Status: "SUCCESS"},
+					{ID: "2", Name: "switch-02", Status: "FAIL", Description: "duplicated name"},
+				},
+			},
+		}, nil, nil
+	}
+
+	status, _, err := getBulkRequestStatus("1234", monitor)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if status.BulkID != "1234" {
+		t.Errorf("Mismatch on bulk id: expected %#v but got %#v", "1234", status.BulkID)
+	}
+	if !isERSBulkStatusDone(status.ExecutionStatus) {
+		t.Errorf("Expected execution status %#v to be done", status.ExecutionStatus)
+	}
+	if status.failCount() != 1 {
+		t.Errorf("Mismatch on fail count: expected 1 but got %d", status.failCount())
+	}
+	if len(status.ResourcesStatus) != 2 || status.ResourcesStatus[1].Description != "duplicated name" {
+		t.Errorf("Mismatch on resources status: got %#v", status.ResourcesStatus)
+	}
+}
+
+func TestBulkRequestFailCountFromResourcesStatus(t *testing.T) {
+	tests := []struct {
+		status ersBulkStatus
+		out    int
+	}{
+		{
+			ersBulkStatus{},
+			0,
+		},
+		{
+			ersBulkStatus{ResourcesStatus: []ersBulkResourceStatus{{Status: "SUCCESS"}, {Status: "FAIL"}, {Status: "failed"}}},
+			2,
+		},
+	}
+
+	for _, test := range tests {
+		out := test.status.failCount()
+		if out != test.out {
+			t.Errorf("Mismatch on val %#v: expected %#v but got %#v", test.status, test.out, out)
+		}
+	}
+}
+
+func TestBulkRequestIsStatusDone(t *testing.T) {
+	tests := map[string]bool{
+		"COMPLETED":   true,
+		"completed":   true,
+		"ABORTED":     true,
+		"IN_PROGRESS": false,
+		"":            false,
+	}
+	for status, expected := range tests {
+		if isERSBulkStatusDone(status) != expected {
+			t.Errorf("Mismatch on val %#v: expected %#v", status, expected)
+		}
+	}
+}
+
+func TestBulkRequestStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":           "1234",
+		"item":         "raw response",
+		"last_updated": "1690000000",
+	}
+	out, err := resourceBulkRequestStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := out["item"]; ok {
+		t.Errorf("Expected item to be removed, got %#v", out["item"])
+	}
+	if out["id"] != "1234" {
+		t.Errorf("Mismatch on id: expected %#v but got %#v", "1234", out["id"])
+	}
+}
+
+func TestBulkRequestGetServiceURL(t *testing.T) {
+	tests := []struct {
+		clientConfig ClientConfig
+		module       string
+		out          string
+	}{
+		{
+			ClientConfig{BaseURL: "https://ise.example.com"},
+			"_ers",
+			"https://ise.example.com:9060/ers/config/networkdevice/bulk/submit",
+		},
+		{
+			ClientConfig{BaseURL: "https://ise.example.com/", UseAPIGateway: true},
+			"_ers",
+			"https://ise.example.com/ers/config/networkdevice/bulk/submit",
+		},
+	}
+	for _, test := range tests {
+		out := getServiceURL(test.clientConfig, test.module, "/ers/config/networkdevice/bulk/submit")
+		if out != test.out {
+			t.Errorf("Mismatch on val %#v: expected %#v but got %#v", test.clientConfig, test.out, out)
+		}
+	}
+}
+
+func TestBulkRequestExpandRequest(t *testing.T) {
+	tests := []struct {
+		operationType string
+		out           string
+	}{
+		{
+			"create",
+			`{"SgtBulkRequest":{"operationType":"create","resourceMediaType":"vnd.com.cisco.ise.trustsec.sgt.1.0+xml","resourcesList":[{"Sgt":{"name":"Employees","value":10}},{"Sgt":{"name":"Contractors","description":"Third parties","value":11}}]}}`,
+		},
+		{
+			"delete",
+			`{"SgtBulkRequest":{"operationType":"delete","resourceMediaType":"vnd.com.cisco.ise.trustsec.sgt.1.0+xml","idList":["1234","5678"]}}`,
+		},
+	}
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, resourceSgtBulkRequest().Schema, map[string]interface{}{
+			"parameters": []interface{}{
+				map[string]interface{}{
+					"operation_type":      test.operationType,
+					"resource_media_type": "vnd.com.cisco.ise.trustsec.sgt.1.0+xml",
+					"resources": []interface{}{
+						map[string]interface{}{"id": "1234", "name": "Employees", "value": 10},
+						map[string]interface{}{"id": "5678", "name": "Contractors", "description": "Third parties", "value": 11},
+					},
+				},
+			},
+		})
+		request := expandRequestSgtBulkRequestBulkRequestForSecurityGroup(context.Background(), "parameters.0", d)
+		out, err := json.Marshal(map[string]interface{}{"SgtBulkRequest": request})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(out) != test.out {
+			t.Errorf("Mismatch on operation %#v: expected %s but got %s", test.operationType, test.out, out)
+		}
+	}
+}
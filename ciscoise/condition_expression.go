@@ -0,0 +1,383 @@
+package ciscoise
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// A condition expression is a compact form of a policy condition tree, such as
+//
+//	Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11" AND NOT ref("Wired_802.1X")
+//
+// NOT binds tighter than AND, which binds tighter than OR. Operands are
+// attribute conditions (dictionary:attribute OPERATOR value), references to
+// library conditions by name or id (ref("name")) and parenthesized
+// expressions. Names with spaces or reserved characters are quoted.
+
+// conditionOperators are the operators of attribute conditions in the ISE API.
+var conditionOperators = []string{
+	"contains", "endsWith", "equals", "greaterOrEquals", "greaterThan", "in",
+	"ipEquals", "ipGreaterThan", "ipLessThan", "ipNotEquals", "lessOrEquals",
+	"lessThan", "macContains", "macEndsWith", "macEquals", "macIn",
+	"macNotContains", "macNotEndsWith", "macNotEquals", "macNotIn",
+	"macNotStartsWith", "macStartsWith", "matches", "notContains",
+	"notEndsWith", "notEquals", "notIn", "notStartsWith", "startsWith",
+}
+
+var conditionBareWordRegexp = regexp.MustCompile(`^[A-Za-z0-9_.#\-]+$`)
+
+type conditionToken struct {
+	value  string
+	quoted bool
+	pos    int
+}
+
+func tokenizeConditionExpression(expression string) ([]conditionToken, error) {
+	tokens := []conditionToken{}
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == ':':
+			tokens = append(tokens, conditionToken{value: string(r), pos: i})
+			i++
+		case r == '"':
+			start := i
+			value := strings.Builder{}
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				value.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start+1)
+			}
+			tokens = append(tokens, conditionToken{value: value.String(), quoted: true, pos: start})
+			i++
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`():"`, runes[i]) {
+				i++
+			}
+			tokens = append(tokens, conditionToken{value: string(runes[start:i]), pos: start})
+		}
+	}
+	return tokens, nil
+}
+
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+}
+
+func (p *conditionParser) peek() *conditionToken {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *conditionParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token != nil && !token.quoted && strings.EqualFold(token.value, keyword)
+}
+
+func (p *conditionParser) errorf(format string, a ...interface{}) error {
+	if token := p.peek(); token != nil {
+		return fmt.Errorf("%s at position %d", fmt.Sprintf(format, a...), token.pos+1)
+	}
+	return fmt.Errorf("%s at the end of the expression", fmt.Sprintf(format, a...))
+}
+
+func (p *conditionParser) expect(value string) error {
+	token := p.peek()
+	if token == nil || token.quoted || token.value != value {
+		return p.errorf("expected %q", value)
+	}
+	p.pos++
+	return nil
+}
+
+// parseBlock parses the operands joined by keyword into a block of
+// conditionType, or returns the operand when there is only one.
+func (p *conditionParser) parseBlock(keyword string, conditionType string, operand func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	children := []interface{}{first}
+	for p.isKeyword(keyword) {
+		p.pos++
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, next)
+	}
+	if len(children) == 1 {
+		return first, nil
+	}
+	return map[string]interface{}{"conditionType": conditionType, "children": children}, nil
+}
+
+func (p *conditionParser) parseOr() (map[string]interface{}, error) {
+	return p.parseBlock("OR", "ConditionOrBlock", p.parseAnd)
+}
+
+func (p *conditionParser) parseAnd() (map[string]interface{}, error) {
+	return p.parseBlock("AND", "ConditionAndBlock", p.parseUnary)
+}
+
+func (p *conditionParser) parseUnary() (map[string]interface{}, error) {
+	if p.isKeyword("NOT") {
+		p.pos++
+		condition, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if condition["isNegate"] == true {
+			delete(condition, "isNegate")
+		} else {
+			condition["isNegate"] = true
+		}
+		return condition, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *conditionParser) parsePrimary() (map[string]interface{}, error) {
+	token := p.peek()
+	if token == nil {
+		return nil, p.errorf("expected a condition")
+	}
+	if !token.quoted && token.value == "(" {
+		p.pos++
+		condition, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return condition, nil
+	}
+	if !token.quoted && strings.EqualFold(token.value, "ref") && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].value == "(" && !p.tokens[p.pos+1].quoted {
+		p.pos += 2
+		reference := p.peek()
+		if reference == nil || (!reference.quoted && strings.ContainsAny(reference.value, "():")) {
+			return nil, p.errorf("expected the name or id of a library condition")
+		}
+		p.pos++
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		condition := map[string]interface{}{"conditionType": "ConditionReference"}
+		if uuidRegexp.MatchString(reference.value) {
+			condition["id"] = reference.value
+		} else {
+			condition["name"] = reference.value
+		}
+		return condition, nil
+	}
+	return p.parseAttribute()
+}
+
+func (p *conditionParser) parseOperand(what string) (string, error) {
+	token := p.peek()
+	if token == nil || (!token.quoted && strings.ContainsAny(token.value, "():")) {
+		return "", p.errorf("expected %s", what)
+	}
+	p.pos++
+	return token.value, nil
+}
+
+func (p *conditionParser) parseAttribute() (map[string]interface{}, error) {
+	dictionaryName, err := p.parseOperand("a dictionary name, ref() or (")
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	attributeName, err := p.parseOperand("an attribute name")
+	if err != nil {
+		return nil, err
+	}
+	token := p.peek()
+	if token == nil || token.quoted {
+		return nil, p.errorf("expected an operator")
+	}
+	operator := expandConditionOperator(token.value)
+	if operator == "" {
+		return nil, p.errorf("unknown operator %q", token.value)
+	}
+	p.pos++
+	attributeValue, err := p.parseOperand("a value")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"conditionType":  "ConditionAttributes",
+		"dictionaryName": dictionaryName,
+		"attributeName":  attributeName,
+		"operator":       operator,
+		"attributeValue": attributeValue,
+	}, nil
+}
+
+// parseConditionExpression returns the condition tree of expression, in the
+// JSON format of the ISE API.
+func parseConditionExpression(expression string) (map[string]interface{}, error) {
+	tokens, err := tokenizeConditionExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+	p := &conditionParser{tokens: tokens}
+	condition, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != nil {
+		return nil, p.errorf("unexpected %q", p.peek().value)
+	}
+	return condition, nil
+}
+
+// expandConditionOperator returns the ISE API operator written as EQUALS,
+// NOT_EQUALS or notEquals, or an empty string if it is unknown.
+func expandConditionOperator(operator string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(operator, "_", ""))
+	for _, conditionOperator := range conditionOperators {
+		if strings.ToLower(conditionOperator) == normalized {
+			return conditionOperator
+		}
+	}
+	return ""
+}
+
+// flattenConditionOperator returns the expression form of an ISE API
+// operator, such as NOT_EQUALS for notEquals.
+func flattenConditionOperator(operator string) string {
+	result := strings.Builder{}
+	for i, r := range operator {
+		if i > 0 && unicode.IsUpper(r) {
+			result.WriteRune('_')
+		}
+		result.WriteRune(unicode.ToUpper(r))
+	}
+	return result.String()
+}
+
+func quoteConditionString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func quoteConditionName(value string) string {
+	if conditionBareWordRegexp.MatchString(value) && !isConditionKeyword(value) {
+		return value
+	}
+	return quoteConditionString(value)
+}
+
+func isConditionKeyword(value string) bool {
+	for _, keyword := range []string{"AND", "OR", "NOT", "ref"} {
+		if strings.EqualFold(value, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatConditionExpression returns the normalized expression of a condition
+// tree in the JSON format of the ISE API. It fails for the conditions the
+// expressions can not represent, such as time and date conditions.
+func formatConditionExpression(condition interface{}) (string, error) {
+	return formatCondition(condition, "")
+}
+
+func formatCondition(v interface{}, parentType string) (string, error) {
+	condition, ok := v.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("condition must be an object")
+	}
+	conditionType := conditionString(condition, "conditionType")
+	negate := condition["isNegate"] == true
+	var expression string
+	switch conditionType {
+	case "ConditionAndBlock", "ConditionOrBlock":
+		children, _ := condition["children"].([]interface{})
+		if len(children) == 0 {
+			return "", fmt.Errorf("%s without children", conditionType)
+		}
+		keyword := " AND "
+		if conditionType == "ConditionOrBlock" {
+			keyword = " OR "
+		}
+		parts := []string{}
+		for _, child := range children {
+			part, err := formatCondition(child, conditionType)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		expression = strings.Join(parts, keyword)
+		if negate || parentType != "" {
+			expression = "(" + expression + ")"
+		}
+	case "ConditionReference":
+		reference := conditionString(condition, "name")
+		if reference == "" {
+			reference = conditionString(condition, "id")
+		}
+		if reference == "" {
+			return "", fmt.Errorf("ConditionReference without name or id")
+		}
+		expression = "ref(" + quoteConditionString(reference) + ")"
+	case "ConditionAttributes":
+		if conditionString(condition, "dictionaryValue") != "" {
+			return "", fmt.Errorf("attribute conditions with a dictionaryValue can not be written as an expression")
+		}
+		expression = fmt.Sprintf("%s:%s %s %s",
+			quoteConditionName(conditionString(condition, "dictionaryName")),
+			quoteConditionName(conditionString(condition, "attributeName")),
+			flattenConditionOperator(conditionString(condition, "operator")),
+			quoteConditionString(conditionString(condition, "attributeValue")))
+	default:
+		return "", fmt.Errorf("%s conditions can not be written as an expression", conditionType)
+	}
+	if negate {
+		expression = "NOT " + expression
+	}
+	return expression, nil
+}
+
+func conditionString(condition map[string]interface{}, key string) string {
+	if condition[key] == nil {
+		return ""
+	}
+	return interfaceToString(condition[key])
+}
+
+// normalizeConditionExpression returns the normalized form of expression, or
+// expression itself if it does not parse.
+func normalizeConditionExpression(expression string) string {
+	condition, err := parseConditionExpression(expression)
+	if err != nil {
+		return expression
+	}
+	normalized, err := formatConditionExpression(condition)
+	if err != nil {
+		return expression
+	}
+	return normalized
+}
@@ -0,0 +1,169 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testConditionExpression is testConditionJSON written as an expression.
+const testConditionExpression = `ref("7f2d1c4a-3b8e-4f55-9a61-0c8e2d4b6a10") AND (Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11" OR NOT ("Network Access":Protocol EQUALS "RADIUS" AND DEVICE:Location STARTS_WITH "All Locations#HQ"))`
+
+func TestConditionExpressionParse(t *testing.T) {
+	cases := map[string]struct {
+		Expression  string
+		Expected    string
+		ExpectError bool
+	}{
+		"three levels": {
+			Expression: testConditionExpression,
+			Expected:   testConditionJSON,
+		},
+		"reference by name": {
+			Expression: `not REF("Wired 802.1X")`,
+			Expected:   `{"conditionType":"ConditionReference","name":"Wired 802.1X","isNegate":true}`,
+		},
+		"precedence": {
+			Expression: `ref(A) OR ref(B) AND ref(C)`,
+			Expected:   `{"conditionType":"ConditionOrBlock","children":[{"conditionType":"ConditionReference","name":"A"},{"conditionType":"ConditionAndBlock","children":[{"conditionType":"ConditionReference","name":"B"},{"conditionType":"ConditionReference","name":"C"}]}]}`,
+		},
+		"api operator and escapes": {
+			Expression: `Radius:Called-Station-ID endsWith "\"HQ\" \\ guest"`,
+			Expected:   `{"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"Called-Station-ID","operator":"endsWith","attributeValue":"\"HQ\" \\ guest"}`,
+		},
+		"double negation": {
+			Expression: `NOT NOT ref(A)`,
+			Expected:   `{"conditionType":"ConditionReference","name":"A"}`,
+		},
+		"unknown operator": {
+			Expression:  `Radius:NAS-Port-Type LIKE "Ethernet"`,
+			ExpectError: true,
+		},
+		"unbalanced parentheses": {
+			Expression:  `(ref(A) OR ref(B)`,
+			ExpectError: true,
+		},
+		"trailing tokens": {
+			Expression:  `ref(A) ref(B)`,
+			ExpectError: true,
+		},
+		"empty": {
+			Expression:  " ",
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		condition, err := parseConditionExpression(tc.Expression)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expected error %t, got %v", tn, tc.ExpectError, err)
+			continue
+		}
+		if tc.ExpectError {
+			continue
+		}
+		var expected interface{}
+		_ = json.Unmarshal([]byte(tc.Expected), &expected)
+		var actual interface{}
+		_ = json.Unmarshal([]byte(interfaceToJSONString(condition)), &actual)
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("bad: %s, expected %s, got %s", tn, tc.Expected, interfaceToJSONString(condition))
+		}
+	}
+}
+
+func TestConditionExpressionFormat(t *testing.T) {
+	cases := map[string]struct {
+		Condition   string
+		Expected    string
+		ExpectError bool
+	}{
+		"three levels": {
+			Condition: testConditionJSON,
+			Expected:  testConditionExpression,
+		},
+		"details added by ISE": {
+			Condition: `{"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X","isNegate":false,"link":{"href":"https://ise/c1"}}`,
+			Expected:  `ref("Wired_802.1X")`,
+		},
+		"quoted names": {
+			Condition: `{"conditionType":"ConditionAttributes","dictionaryName":"Network Access","attributeName":"AND","operator":"notStartsWith","attributeValue":"x"}`,
+			Expected:  `"Network Access":"AND" NOT_STARTS_WITH "x"`,
+		},
+		"time condition": {
+			Condition:   `{"conditionType":"TimeAndDateCondition","datesRange":{"startDate":"2026-01-01"}}`,
+			ExpectError: true,
+		},
+		"dictionary value": {
+			Condition:   `{"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"Called-Station-ID","operator":"equals","dictionaryValue":"Internal"}`,
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		var condition interface{}
+		_ = json.Unmarshal([]byte(tc.Condition), &condition)
+		expression, err := formatConditionExpression(condition)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expected error %t, got %v", tn, tc.ExpectError, err)
+			continue
+		}
+		if !tc.ExpectError && expression != tc.Expected {
+			t.Errorf("bad: %s, expected %s, got %s", tn, tc.Expected, expression)
+		}
+	}
+}
+
+func TestConditionExpressionFlatten(t *testing.T) {
+	var condition interface{}
+	_ = json.Unmarshal([]byte(`{"conditionType":"ConditionAndBlock","children":[
+	  {"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X","isNegate":true},
+	  {"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"NAS-Port-Type","operator":"equals","attributeValue":"Ethernet"}
+	]}`), &condition)
+	configured := `not ref(Wired_802.1X) and Radius:NAS-Port-Type equals Ethernet`
+	if value := flattenConditionExpression(condition, configured); value != configured {
+		t.Errorf("expected the configured expression to be kept, got %s", value)
+	}
+	expected := `NOT ref("Wired_802.1X") AND Radius:NAS-Port-Type EQUALS "Ethernet"`
+	if value := flattenConditionExpression(condition, `ref(Wired_802.1X)`); value != expected {
+		t.Errorf("expected %s, got %s", expected, value)
+	}
+	if value := flattenConditionExpression(nil, configured); value != "" {
+		t.Errorf("expected an empty expression without a condition, got %s", value)
+	}
+}
+
+func TestConditionExpressionRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDeviceAdministrationPolicySet().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":                 "Switches",
+				"condition_expression": testConditionExpression,
+			},
+		},
+	})
+	request := expandRequestDeviceAdministrationPolicySetCreateDeviceAdminPolicySet(context.Background(), "parameters.0", d)
+	body, ok, err := deviceAdminPolicySetConditionJSON.request(d, nil, request)
+	if err != nil || !ok {
+		t.Fatalf("expected condition_expression to be used, got %v", err)
+	}
+	if body["name"] != "Switches" {
+		t.Errorf("expected the policy set attributes to be kept, got %v", body)
+	}
+	if !conditionJSONEqual(interfaceToJSONString(body["condition"]), testConditionJSON) {
+		t.Errorf("expected the parsed condition tree, got %s", interfaceToJSONString(body["condition"]))
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceDeviceAdministrationPolicySet().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":                 "Switches",
+				"condition_expression": `ref("Wired_802.1X")`,
+			},
+		},
+	})
+	if _, _, err := deviceAdminPolicySetConditionJSON.request(d, nil, request); err == nil {
+		t.Errorf("expected an error when a library condition can not be looked up")
+	}
+}
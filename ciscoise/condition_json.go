@@ -0,0 +1,494 @@
+package ciscoise
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// conditionJSON describes where a policy resource keeps its condition tree.
+// The SDK models most condition trees only two levels deep, so when the
+// condition_json or condition_expression attribute is set the requests are
+// sent with the tree as given and the tree is read back from the raw response.
+type conditionJSON struct {
+	// key is the condition_json attribute.
+	key string
+	// expressionKey is the condition_expression attribute, empty for the
+	// resources without one.
+	expressionKey string
+	// nameKey is the condition_name attribute, empty for the resources
+	// without one.
+	nameKey string
+	// path is the location of the condition in the API object. It is empty
+	// for library conditions, where the object is the condition itself.
+	path []string
+	// deviceAdmin selects the library where condition references by name
+	// are looked up.
+	deviceAdmin bool
+}
+
+var (
+	ruleConditionJSON = conditionJSON{
+		key:           "parameters.0.rule.0.condition_json",
+		expressionKey: "parameters.0.rule.0.condition_expression",
+		nameKey:       "parameters.0.rule.0.condition_name",
+		path:          []string{"rule", "condition"},
+	}
+	policySetConditionJSON = conditionJSON{
+		key:           "parameters.0.condition_json",
+		expressionKey: "parameters.0.condition_expression",
+		nameKey:       "parameters.0.condition_name",
+		path:          []string{"condition"},
+	}
+	libraryConditionJSON = conditionJSON{key: "parameters.0.condition_json"}
+
+	deviceAdminRuleConditionJSON = conditionJSON{
+		key:           ruleConditionJSON.key,
+		expressionKey: ruleConditionJSON.expressionKey,
+		nameKey:       ruleConditionJSON.nameKey,
+		path:          ruleConditionJSON.path,
+		deviceAdmin:   true,
+	}
+	deviceAdminPolicySetConditionJSON = conditionJSON{
+		key:           policySetConditionJSON.key,
+		expressionKey: policySetConditionJSON.expressionKey,
+		nameKey:       policySetConditionJSON.nameKey,
+		path:          policySetConditionJSON.path,
+		deviceAdmin:   true,
+	}
+	deviceAdminLibraryConditionJSON = conditionJSON{key: libraryConditionJSON.key, deviceAdmin: true}
+)
+
+// libraryConditionKeys are the keys of a library condition that are not part
+// of its tree.
+var libraryConditionKeys = map[string]bool{
+	"id":          true,
+	"name":        true,
+	"description": true,
+	"link":        true,
+}
+
+func resourceConditionJSONSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `Condition tree of any depth, as a JSON object in the ISE API format (conditionType, isNegate, children, attributeName, ...).
+When set, it takes precedence over the condition blocks, which only cover two levels of children.`,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validateConditionJSON(),
+		DiffSuppressFunc: diffSuppressConditionJSON(),
+	}
+}
+
+func resourceConditionExpressionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `Condition as an expression, such as Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11" AND NOT ref("Wired_802.1X").
+NOT binds tighter than AND, which binds tighter than OR, and parentheses nest conditions to any depth.
+Library conditions are referenced by name or id with ref(). When set, it takes precedence over the condition blocks; condition_json takes precedence over it.`,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validateConditionExpression(),
+		DiffSuppressFunc: diffSuppressConditionExpression(),
+	}
+}
+
+func resourceConditionNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `Name of a library condition to use as the condition, resolved to its id.
+condition_json and condition_expression take precedence over it.`,
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+}
+
+// condition returns the condition tree of the condition_json,
+// condition_expression or condition_name attribute, nil when none is set.
+func (c conditionJSON) condition(d *schema.ResourceData) (map[string]interface{}, error) {
+	if vConditionJSON := interfaceToString(d.Get(c.key)); vConditionJSON != "" {
+		condition := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(vConditionJSON), &condition); err != nil {
+			return nil, fmt.Errorf("invalid condition_json: %v", err)
+		}
+		return condition, nil
+	}
+	if vConditionExpression := c.getString(d, c.expressionKey); vConditionExpression != "" {
+		condition, err := parseConditionExpression(vConditionExpression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition_expression: %v", err)
+		}
+		return condition, nil
+	}
+	if vConditionName := c.getString(d, c.nameKey); vConditionName != "" {
+		return map[string]interface{}{"conditionType": "ConditionReference", "name": vConditionName}, nil
+	}
+	return nil, nil
+}
+
+// getString returns the string attribute key, empty when the resource has no
+// such attribute.
+func (c conditionJSON) getString(d *schema.ResourceData, key string) string {
+	if key == "" {
+		return ""
+	}
+	return interfaceToString(d.Get(key))
+}
+
+// request returns the body to send instead of request, with the condition
+// replaced by the condition_json, condition_expression or condition_name
+// attribute and the library conditions referenced by name resolved to their
+// id. It returns false when none of the attributes is set.
+func (c conditionJSON) request(d *schema.ResourceData, m interface{}, request interface{}) (map[string]interface{}, bool, error) {
+	condition, err := c.condition(d)
+	if err != nil || condition == nil {
+		return nil, false, err
+	}
+	if err := c.resolveReferences(m, condition, map[string]string{}); err != nil {
+		return nil, false, err
+	}
+	body := map[string]interface{}{}
+	if b, err := json.Marshal(request); err == nil {
+		_ = json.Unmarshal(b, &body)
+	}
+	if len(c.path) == 0 {
+		for key := range body {
+			if !libraryConditionKeys[key] {
+				delete(body, key)
+			}
+		}
+		for key, value := range condition {
+			if !libraryConditionKeys[key] {
+				body[key] = value
+			}
+		}
+		return body, true, nil
+	}
+	parent := body
+	for _, key := range c.path[:len(c.path)-1] {
+		child, ok := parent[key].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			parent[key] = child
+		}
+		parent = child
+	}
+	parent[c.path[len(c.path)-1]] = condition
+	return body, true, nil
+}
+
+// resolveReferences sets the id of the ConditionReference nodes of v that
+// only have a name, looking the names up in the library conditions.
+func (c conditionJSON) resolveReferences(m interface{}, v interface{}, ids map[string]string) error {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if value["conditionType"] == "ConditionReference" && conditionString(value, "id") == "" && conditionString(value, "name") != "" {
+			id, err := c.libraryConditionID(m, conditionString(value, "name"), ids)
+			if err != nil {
+				return err
+			}
+			value["id"] = id
+		}
+		for _, item := range value {
+			if err := c.resolveReferences(m, item, ids); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			if err := c.resolveReferences(m, item, ids); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// libraryConditionID returns the id of the library condition name, caching
+// it in ids.
+func (c conditionJSON) libraryConditionID(m interface{}, name string, ids map[string]string) (string, error) {
+	if id, ok := ids[name]; ok {
+		return id, nil
+	}
+	clientConfig, ok := m.(ClientConfig)
+	if !ok {
+		return "", fmt.Errorf("unable to look up the library condition %s", name)
+	}
+	client := clientConfig.Client
+	id := ""
+	if c.deviceAdmin {
+		response, _, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByName(name)
+		if err != nil || response == nil || response.Response == nil {
+			return "", fmt.Errorf("library condition %s not found: %v", name, err)
+		}
+		id = response.Response.ID
+	} else {
+		response, _, err := client.NetworkAccessConditions.GetNetworkAccessConditionByName(name)
+		if err != nil || response == nil || response.Response == nil {
+			return "", fmt.Errorf("library condition %s not found: %v", name, err)
+		}
+		id = response.Response.ID
+	}
+	if id == "" {
+		return "", fmt.Errorf("library condition %s not found", name)
+	}
+	ids[name] = id
+	return id, nil
+}
+
+// flatten sets the condition_json, condition_expression and condition_name
+// attributes of the flattened item to the condition tree of the object id
+// found in the raw response. The attributes are only set when they are used,
+// and keep their configured text while the tree on ISE matches it.
+func (c conditionJSON) flatten(d *schema.ResourceData, item []map[string]interface{}, restyResp *resty.Response, id string) {
+	vConditionJSON := interfaceToString(d.Get(c.key))
+	vConditionExpression := c.getString(d, c.expressionKey)
+	vConditionName := c.getString(d, c.nameKey)
+	if (vConditionJSON == "" && vConditionExpression == "" && vConditionName == "") || restyResp == nil {
+		return
+	}
+	condition, err := c.responseCondition(restyResp.Body(), id)
+	if err != nil {
+		log.Printf("[WARN] Unable to read the condition tree of %s: %v", id, err)
+		return
+	}
+	if vConditionJSON != "" {
+		setFlattenedValue(item, strings.TrimPrefix(c.key, "parameters."), flattenConditionJSON(condition, vConditionJSON))
+	}
+	if vConditionExpression != "" {
+		setFlattenedValue(item, strings.TrimPrefix(c.expressionKey, "parameters."), flattenConditionExpression(condition, vConditionExpression))
+	}
+	if vConditionName != "" {
+		setFlattenedValue(item, strings.TrimPrefix(c.nameKey, "parameters."), flattenConditionName(condition))
+	}
+}
+
+// flattenConditionJSON returns condition, a tree read from ISE, as JSON, or
+// conditionJSON when the tree matches it.
+func flattenConditionJSON(condition interface{}, conditionJSON string) string {
+	if condition == nil {
+		return ""
+	}
+	value := interfaceToJSONString(condition)
+	if conditionJSONEqual(value, conditionJSON) {
+		return conditionJSON
+	}
+	return value
+}
+
+// flattenConditionExpression returns the expression of condition, a tree
+// read from ISE, or expression when the tree matches it.
+func flattenConditionExpression(condition interface{}, expression string) string {
+	if condition == nil {
+		return ""
+	}
+	if expected, err := parseConditionExpression(expression); err == nil && conditionJSONEqual(interfaceToJSONString(condition), interfaceToJSONString(expected)) {
+		return expression
+	}
+	value, err := formatConditionExpression(condition)
+	if err != nil {
+		log.Printf("[WARN] Unable to write the condition tree as an expression: %v", err)
+		return ""
+	}
+	return value
+}
+
+// flattenConditionName returns the name of the library condition when
+// condition, a tree read from ISE, is a plain reference to one, or an empty
+// string otherwise.
+func flattenConditionName(condition interface{}) string {
+	reference, ok := condition.(map[string]interface{})
+	if !ok || conditionString(reference, "conditionType") != "ConditionReference" || reference["isNegate"] == true {
+		return ""
+	}
+	return conditionString(reference, "name")
+}
+
+// responseCondition returns the normalized condition tree of the object id
+// in a raw API response, which holds one object or a list of them.
+func (c conditionJSON) responseCondition(body []byte, id string) (interface{}, error) {
+	response := struct {
+		Response interface{} `json:"response"`
+	}{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	idPath := []string{"id"}
+	if len(c.path) > 0 {
+		idPath = append(append([]string{}, c.path[:len(c.path)-1]...), "id")
+	}
+	var object interface{}
+	switch v := response.Response.(type) {
+	case map[string]interface{}:
+		object = v
+	case []interface{}:
+		for _, item := range v {
+			if interfaceToString(getJSONPath(item, idPath)) == id {
+				object = item
+				break
+			}
+		}
+	}
+	if object == nil {
+		return nil, fmt.Errorf("object not found in the response")
+	}
+	if len(c.path) > 0 {
+		return normalizeConditionJSON(getJSONPath(object, c.path)), nil
+	}
+	condition := map[string]interface{}{}
+	for key, value := range object.(map[string]interface{}) {
+		if !libraryConditionKeys[key] {
+			condition[key] = value
+		}
+	}
+	return normalizeConditionJSON(condition), nil
+}
+
+func getJSONPath(v interface{}, path []string) interface{} {
+	for _, key := range path {
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = object[key]
+	}
+	return v
+}
+
+// setFlattenedValue sets key, a path such as 0.rule.0.condition_json, in the
+// output of a flatten function.
+func setFlattenedValue(item []map[string]interface{}, key string, value interface{}) {
+	var current interface{} = item
+	parts := strings.Split(key, ".")
+	for i, part := range parts {
+		last := i == len(parts)-1
+		if index, err := strconv.Atoi(part); err == nil {
+			switch v := current.(type) {
+			case []map[string]interface{}:
+				if index >= len(v) {
+					return
+				}
+				current = v[index]
+			case []interface{}:
+				if index >= len(v) {
+					return
+				}
+				current = v[index]
+			default:
+				return
+			}
+			continue
+		}
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return
+		}
+		if last {
+			object[part] = value
+			return
+		}
+		current = object[part]
+	}
+}
+
+// normalizeConditionJSON drops the keys that do not change the meaning of a
+// condition tree: links, empty values and isNegate set to false.
+func normalizeConditionJSON(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		normalized := map[string]interface{}{}
+		for key, item := range value {
+			if key == "link" || (key == "isNegate" && item == false) {
+				continue
+			}
+			if item = normalizeConditionJSON(item); item != nil {
+				normalized[key] = item
+			}
+		}
+		if len(normalized) == 0 {
+			return nil
+		}
+		return normalized
+	case []interface{}:
+		normalized := []interface{}{}
+		for _, item := range value {
+			normalized = append(normalized, normalizeConditionJSON(item))
+		}
+		if len(normalized) == 0 {
+			return nil
+		}
+		return normalized
+	case string:
+		if value == "" {
+			return nil
+		}
+	}
+	return v
+}
+
+// conditionJSONEqual reports whether the condition tree actual, usually read
+// from ISE, matches the configured tree expected. ISE adds details to the
+// conditions it returns, such as the attributes of referenced library
+// conditions, so only the keys of expected are compared.
+func conditionJSONEqual(actual string, expected string) bool {
+	var vActual, vExpected interface{}
+	if err := json.Unmarshal([]byte(actual), &vActual); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(expected), &vExpected); err != nil {
+		return false
+	}
+	return conditionTreeContains(normalizeConditionJSON(vActual), normalizeConditionJSON(vExpected))
+}
+
+func conditionTreeContains(actual interface{}, expected interface{}) bool {
+	switch vExpected := expected.(type) {
+	case map[string]interface{}:
+		vActual, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if _, ok := vExpected["isNegate"]; !ok && vActual["isNegate"] != nil {
+			return false
+		}
+		for key, item := range vExpected {
+			if !conditionTreeContains(vActual[key], item) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		vActual, ok := actual.([]interface{})
+		if !ok || len(vActual) != len(vExpected) {
+			return false
+		}
+		for i := range vExpected {
+			if !conditionTreeContains(vActual[i], vExpected[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(actual, expected)
+}
+
+// executeConditionJSONRequest sends body to path on the OpenAPI module and
+// decodes the answer into result, as the SDK operation would.
+func executeConditionJSONRequest(clientConfig ClientConfig, operation string, method string, path string, body interface{}, result interface{}) (*resty.Response, error) {
+	response, err := clientConfig.Client.RestyClient().R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
+		SetBody(body).
+		SetResult(result).
+		Execute(method, getServiceURL(clientConfig, "_ui", path))
+	if err != nil {
+		return response, err
+	}
+	if response.IsError() {
+		return response, fmt.Errorf("error with operation %s", operation)
+	}
+	return response, nil
+}
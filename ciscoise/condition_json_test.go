@@ -0,0 +1,245 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testConditionJSON is an authorization condition three levels deep.
+const testConditionJSON = `{
+  "conditionType": "ConditionAndBlock",
+  "children": [
+    {"conditionType": "ConditionReference", "id": "7f2d1c4a-3b8e-4f55-9a61-0c8e2d4b6a10"},
+    {
+      "conditionType": "ConditionOrBlock",
+      "children": [
+        {"conditionType": "ConditionAttributes", "dictionaryName": "Radius", "attributeName": "NAS-Port-Type", "operator": "equals", "attributeValue": "Wireless - IEEE 802.11"},
+        {
+          "conditionType": "ConditionAndBlock",
+          "isNegate": true,
+          "children": [
+            {"conditionType": "ConditionAttributes", "dictionaryName": "Network Access", "attributeName": "Protocol", "operator": "equals", "attributeValue": "RADIUS"},
+            {"conditionType": "ConditionAttributes", "dictionaryName": "DEVICE", "attributeName": "Location", "operator": "startsWith", "attributeValue": "All Locations#HQ"}
+          ]
+        }
+      ]
+    }
+  ]
+}`
+
+func TestConditionJSONEqual(t *testing.T) {
+	cases := map[string]struct {
+		Actual, Expected string
+		ExpectEqual      bool
+	}{
+		"same tree": {
+			Actual:      testConditionJSON,
+			Expected:    testConditionJSON,
+			ExpectEqual: true,
+		},
+		"formatting and key order": {
+			Actual:      `{"children":[{"id":"c1","conditionType":"ConditionReference"},{"conditionType":"ConditionReference","id":"c2"}],"conditionType":"ConditionOrBlock"}`,
+			Expected:    `{"conditionType": "ConditionOrBlock", "children": [{"conditionType": "ConditionReference", "id": "c1"}, {"conditionType": "ConditionReference", "id": "c2"}]}`,
+			ExpectEqual: true,
+		},
+		"details added by ISE": {
+			Actual:      `{"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X","isNegate":false,"link":{"href":"https://ise/c1"},"description":""}`,
+			Expected:    `{"conditionType":"ConditionReference","id":"c1"}`,
+			ExpectEqual: true,
+		},
+		"negated on ISE": {
+			Actual:      `{"conditionType":"ConditionReference","id":"c1","isNegate":true}`,
+			Expected:    `{"conditionType":"ConditionReference","id":"c1"}`,
+			ExpectEqual: false,
+		},
+		"child order": {
+			Actual:      `{"conditionType":"ConditionOrBlock","children":[{"conditionType":"ConditionReference","id":"c2"},{"conditionType":"ConditionReference","id":"c1"}]}`,
+			Expected:    `{"conditionType":"ConditionOrBlock","children":[{"conditionType":"ConditionReference","id":"c1"},{"conditionType":"ConditionReference","id":"c2"}]}`,
+			ExpectEqual: false,
+		},
+		"removed child": {
+			Actual:      `{"conditionType":"ConditionOrBlock","children":[{"conditionType":"ConditionReference","id":"c1"},{"conditionType":"ConditionReference","id":"c2"}]}`,
+			Expected:    `{"conditionType":"ConditionOrBlock","children":[{"conditionType":"ConditionReference","id":"c1"}]}`,
+			ExpectEqual: false,
+		},
+		"invalid JSON": {
+			Actual:      testConditionJSON,
+			Expected:    `{"conditionType":`,
+			ExpectEqual: false,
+		},
+	}
+	for tn, tc := range cases {
+		if conditionJSONEqual(tc.Actual, tc.Expected) != tc.ExpectEqual {
+			t.Errorf("bad: %s, expected conditionJSONEqual to return %t", tn, tc.ExpectEqual)
+		}
+	}
+}
+
+func TestConditionJSONRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetworkAccessAuthorizationRules().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"policy_id": "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10",
+				"profile":   []interface{}{"PermitAccess"},
+				"rule": []interface{}{
+					map[string]interface{}{
+						"name":           "Wireless HQ",
+						"state":          "enabled",
+						"condition_json": testConditionJSON,
+					},
+				},
+			},
+		},
+	})
+	request := expandRequestNetworkAccessAuthorizationRulesCreateNetworkAccessAuthorizationRule(context.Background(), "parameters.0", d)
+	body, ok, err := ruleConditionJSON.request(d, nil, request)
+	if err != nil || !ok {
+		t.Fatalf("expected condition_json to be used, got %v", err)
+	}
+	rule, _ := body["rule"].(map[string]interface{})
+	if rule["name"] != "Wireless HQ" || rule["state"] != "enabled" {
+		t.Errorf("expected the rule attributes to be kept, got %v", rule)
+	}
+	if !reflect.DeepEqual(body["profile"], []interface{}{"PermitAccess"}) {
+		t.Errorf("expected the profile to be kept, got %v", body["profile"])
+	}
+	var expected interface{}
+	_ = json.Unmarshal([]byte(testConditionJSON), &expected)
+	if !reflect.DeepEqual(rule["condition"], expected) {
+		t.Errorf("expected the condition tree to be sent as given, got %v", rule["condition"])
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceNetworkAccessAuthorizationRules().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{"name": "Wireless HQ"},
+				},
+			},
+		},
+	})
+	if _, ok, _ := ruleConditionJSON.request(d, nil, request); ok {
+		t.Errorf("expected the SDK request to be used without condition_json")
+	}
+}
+
+func TestConditionJSONLibraryRequest(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetworkAccessConditions().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":           "Wireless_HQ",
+				"description":    "Wireless users at HQ",
+				"condition_type": "LibraryConditionAttributes",
+				"condition_json": `{"conditionType":"LibraryConditionOrBlock","name":"ignored","children":[{"conditionType":"ConditionReference","id":"c1"},{"conditionType":"ConditionReference","id":"c2"}]}`,
+			},
+		},
+	})
+	request := expandRequestNetworkAccessConditionsCreateNetworkAccessCondition(context.Background(), "parameters.0", d)
+	body, ok, err := libraryConditionJSON.request(d, nil, request)
+	if err != nil || !ok {
+		t.Fatalf("expected condition_json to be used, got %v", err)
+	}
+	if body["name"] != "Wireless_HQ" || body["description"] != "Wireless users at HQ" {
+		t.Errorf("expected the name and description of the parameters, got %v", body)
+	}
+	if body["conditionType"] != "LibraryConditionOrBlock" {
+		t.Errorf("expected the conditionType of condition_json, got %v", body["conditionType"])
+	}
+	if children, _ := body["children"].([]interface{}); len(children) != 2 {
+		t.Errorf("expected the children of condition_json, got %v", body["children"])
+	}
+}
+
+func TestConditionJSONResponseCondition(t *testing.T) {
+	body := []byte(`{"response":[
+	  {"rule":{"id":"r1","name":"Guest","condition":{"conditionType":"ConditionReference","id":"c9","isNegate":false}}},
+	  {"rule":{"id":"r2","name":"Wireless HQ","condition":` + testConditionJSON + `},"profile":["PermitAccess"]}
+	],"version":"1.0.0"}`)
+	condition, err := ruleConditionJSON.responseCondition(body, "r2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !conditionJSONEqual(interfaceToJSONString(condition), testConditionJSON) {
+		t.Errorf("expected the condition of r2, got %s", interfaceToJSONString(condition))
+	}
+	if _, err := ruleConditionJSON.responseCondition(body, "r3"); err == nil {
+		t.Errorf("expected an error for a missing rule")
+	}
+
+	library := []byte(`{"response":{"id":"l1","name":"Wireless_HQ","description":"","conditionType":"LibraryConditionAndBlock","isNegate":false,"children":[{"conditionType":"ConditionReference","id":"c1"},{"conditionType":"ConditionReference","id":"c2"}]}}`)
+	condition, err = libraryConditionJSON.responseCondition(library, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"children":[{"conditionType":"ConditionReference","id":"c1"},{"conditionType":"ConditionReference","id":"c2"}],"conditionType":"LibraryConditionAndBlock"}`
+	if interfaceToJSONString(condition) != expected {
+		t.Errorf("expected %s, got %s", expected, interfaceToJSONString(condition))
+	}
+}
+
+func TestConditionJSONSetFlattenedValue(t *testing.T) {
+	item := []map[string]interface{}{
+		{"rule": []map[string]interface{}{{"name": "Wireless HQ"}}},
+	}
+	setFlattenedValue(item, "0.rule.0.condition_json", "{}")
+	rule := item[0]["rule"].([]map[string]interface{})[0]
+	if rule["condition_json"] != "{}" {
+		t.Errorf("expected condition_json to be set, got %v", rule)
+	}
+	setFlattenedValue(item, "0.profile.0.condition_json", "{}")
+	setFlattenedValue(nil, "0.condition_json", "{}")
+}
+
+func TestConditionNameRequest(t *testing.T) {
+	m := newTestClientConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/policy/network-access/condition/condition-by-name/Wired_802.1X" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"response":{"id":"c1","name":"Wired_802.1X","conditionType":"LibraryConditionAttributes"}}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkAccessPolicySet().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":           "Wired",
+				"service_name":   "Default Network Access",
+				"condition_name": "Wired_802.1X",
+			},
+		},
+	})
+	request := expandRequestNetworkAccessPolicySetCreateNetworkAccessPolicySet(context.Background(), "parameters.0", d)
+	body, ok, err := policySetConditionJSON.request(d, m, request)
+	if err != nil || !ok {
+		t.Fatalf("expected condition_name to be used, got %v", err)
+	}
+	expected := `{"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X"}`
+	if !conditionJSONEqual(interfaceToJSONString(body["condition"]), expected) {
+		t.Errorf("expected %s, got %s", expected, interfaceToJSONString(body["condition"]))
+	}
+	if body["serviceName"] != "Default Network Access" {
+		t.Errorf("expected the policy set attributes to be kept, got %v", body)
+	}
+
+	cases := map[string]struct {
+		Condition string
+		Expected  string
+	}{
+		"reference":         {`{"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X"}`, "Wired_802.1X"},
+		"negated reference": {`{"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X","isNegate":true}`, ""},
+		"block":             {`{"conditionType":"ConditionAndBlock","children":[{"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X"}]}`, ""},
+	}
+	for tn, tc := range cases {
+		var condition interface{}
+		_ = json.Unmarshal([]byte(tc.Condition), &condition)
+		if value := flattenConditionName(condition); value != tc.Expected {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, value)
+		}
+	}
+}
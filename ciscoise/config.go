@@ -2,9 +2,15 @@ package ciscoise
 
 import (
 	"context"
+	"log"
+	"net/http"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/go-resty/resty/v2"
+
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,11 +28,29 @@ type Config struct {
 	UseAPIGateway  string
 	UseCSRFToken   string
 	RequestTimeout int
+	// CACertificate, ClientCertificate and ClientKey hold PEM content or a file path.
+	CACertificate     string
+	ClientCertificate string
+	ClientKey         string
+	TLSServerName     string
+
+	MaxRetries   int
+	RetryMinWait int
+	RetryMaxWait int
+	// FallbackBaseURLs are the PANs used, in order, when BaseURL does not answer.
+	FallbackBaseURLs []string
+	// MaxConcurrentRequests bounds the requests in flight against ISE, 0 means no limit.
+	MaxConcurrentRequests int
+
+	limiter requestLimiter
 }
 
 type ClientConfig struct {
 	Client           *isegosdk.Client
+	NodeClients      *nodeClients
 	EnableAutoImport bool
+	BaseURL          string
+	UseAPIGateway    bool
 }
 
 // NewClient returns a new Cisco Identity Services Engine client.
@@ -39,13 +63,139 @@ func (c *Config) NewClient() (*isegosdk.Client, error) {
 	if err != nil {
 		return client, err
 	}
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return client, err
+	}
+	client.RestyClient().SetTLSClientConfig(tlsConfig)
 	client.RestyClient().SetLogger(createLogger())
 	if c.RequestTimeout > 0 {
 		client.RestyClient().SetTimeout(time.Duration(c.RequestTimeout) * time.Second)
 	}
+	c.configureRetries(client.RestyClient())
+	err = c.configureFailover(client.RestyClient())
+	if err != nil {
+		return client, err
+	}
+	c.configureRequestLimiter(client.RestyClient())
 	return client, err
 }
 
+// configureFailover sends the requests to the fallback PANs when the primary
+// one fails. It wraps the current transport, so it must run after any TLS
+// configuration.
+func (c *Config) configureFailover(restyClient *resty.Client) error {
+	if len(c.FallbackBaseURLs) == 0 {
+		return nil
+	}
+	baseURLs := append([]string{c.BaseURL}, c.FallbackBaseURLs...)
+	transport, err := newFailoverTransport(baseURLs, restyClient.GetClient().Transport)
+	if err != nil {
+		return err
+	}
+	restyClient.SetTransport(transport)
+	return nil
+}
+
+// configureRequestLimiter installs a semaphore around every request made with
+// the resty client. The same semaphore is shared with the node clients of the
+// personas resources. It wraps the current transport, so it must run after any
+// TLS configuration.
+func (c *Config) configureRequestLimiter(restyClient *resty.Client) {
+	c.limiter = newRequestLimiter(c.MaxConcurrentRequests)
+	restyClient.SetTransport(newLimitedTransport(c.limiter, restyClient.GetClient().Transport))
+}
+
+// configureRetries enables retries with exponential backoff on the resty client.
+func (c *Config) configureRetries(restyClient *resty.Client) {
+	if c.MaxRetries <= 0 {
+		return
+	}
+	minWait := time.Duration(c.RetryMinWait) * time.Second
+	if minWait <= 0 {
+		// The resty backoff panics on a zero wait time.
+		minWait = time.Second
+	}
+	maxWait := time.Duration(c.RetryMaxWait) * time.Second
+	if maxWait < minWait {
+		maxWait = minWait
+	}
+	restyClient.
+		SetRetryCount(c.MaxRetries).
+		SetRetryWaitTime(minWait).
+		SetRetryMaxWaitTime(maxWait).
+		SetRetryAfter(retryAfterFromHeader).
+		AddRetryCondition(isRetryableResponse).
+		AddRetryHook(logRetry)
+}
+
+// isRetryableResponse decides if a request should be retried.
+// Throttling (429) and unavailable (503) answers are retried for every method,
+// since ISE rejected the request before processing it. Gateway errors and
+// connection failures are only retried for idempotent methods, as the request
+// may have reached ISE.
+func isRetryableResponse(response *resty.Response, err error) bool {
+	statusCode := 0
+	method := ""
+	if response != nil {
+		statusCode = response.StatusCode()
+		if response.Request != nil {
+			method = response.Request.Method
+		}
+	}
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotentMethod(method)
+	}
+	if err != nil && statusCode == 0 {
+		return isIdempotentMethod(method)
+	}
+	return false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfterFromHeader returns the wait requested by the Retry-After header,
+// either in seconds or as an HTTP date. A zero duration makes resty use the
+// exponential backoff instead.
+func retryAfterFromHeader(client *resty.Client, response *resty.Response) (time.Duration, error) {
+	if response == nil {
+		return 0, nil
+	}
+	retryAfter := response.Header().Get("Retry-After")
+	if retryAfter == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil && time.Until(date) > 0 {
+		return time.Until(date), nil
+	}
+	return 0, nil
+}
+
+// logRetry logs every transient failure that matched the retry conditions.
+func logRetry(response *resty.Response, err error) {
+	if response == nil || response.Request == nil {
+		log.Printf("[WARN] Transient failure on request: %v", err)
+		return
+	}
+	if err != nil {
+		log.Printf("[WARN] Transient failure on %s %s (attempt %d): %v", response.Request.Method, response.Request.URL, response.Request.Attempt, err)
+		return
+	}
+	log.Printf("[WARN] Transient failure on %s %s (attempt %d): %s", response.Request.Method, response.Request.URL, response.Request.Attempt, response.Status())
+}
+
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -58,6 +208,23 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		UseAPIGateway:  d.Get("use_api_gateway").(string),
 		UseCSRFToken:   d.Get("use_csrf_token").(string),
 		RequestTimeout: d.Get("single_request_timeout").(int),
+
+		CACertificate:     d.Get("ca_certificate").(string),
+		ClientCertificate: d.Get("client_certificate").(string),
+		ClientKey:         d.Get("client_key").(string),
+		TLSServerName:     d.Get("tls_server_name").(string),
+
+		MaxRetries:   d.Get("max_retries").(int),
+		RetryMinWait: d.Get("retry_min_wait").(int),
+		RetryMaxWait: d.Get("retry_max_wait").(int),
+
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+	}
+	for _, fallbackBaseURL := range d.Get("fallback_base_urls").([]interface{}) {
+		config.FallbackBaseURLs = append(config.FallbackBaseURLs, interfaceToString(fallbackBaseURL))
+	}
+	if len(config.FallbackBaseURLs) == 0 && os.Getenv("ISE_FALLBACK_BASE_URLS") != "" {
+		config.FallbackBaseURLs = strings.Split(os.Getenv("ISE_FALLBACK_BASE_URLS"), ",")
 	}
 
 	client, err := config.NewClient()
@@ -75,9 +242,27 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		boolValue = false
 	}
 
+	useAPIGateway, err := strconv.ParseBool(config.UseAPIGateway)
+	if err != nil {
+		useAPIGateway = false
+	}
+
+	nodeClients, err := newNodeClients(config)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create Cisco Identity Services Engine node clients",
+			Detail:   err.Error(),
+		})
+		return nil, diags
+	}
+
 	clientConfig := ClientConfig{
 		Client:           client,
+		NodeClients:      nodeClients,
 		EnableAutoImport: boolValue,
+		BaseURL:          config.BaseURL,
+		UseAPIGateway:    useAPIGateway,
 	}
 	return clientConfig, diags
 }
@@ -0,0 +1,111 @@
+package ciscoise
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// newTestClientConfig returns the client configuration of a fake ISE answering
+// with handler through the API gateway. The server is closed when the test
+// ends.
+func newTestClientConfig(t *testing.T, handler http.HandlerFunc) ClientConfig {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+	config := Config{BaseURL: server.URL, Username: "admin", Password: "password", SSLVerify: "false", UseAPIGateway: "true", UseCSRFToken: "false"}
+	client, err := config.NewClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return ClientConfig{Client: client, BaseURL: server.URL, UseAPIGateway: true}
+}
+
+func TestConfigIsRetryableResponse(t *testing.T) {
+	restyResponse := func(method string, statusCode int) *resty.Response {
+		response := &resty.Response{Request: &resty.Request{Method: method}}
+		if statusCode != 0 {
+			response.RawResponse = &http.Response{StatusCode: statusCode}
+		}
+		return response
+	}
+	connectionReset := errors.New("connection reset by peer")
+	tests := []struct {
+		response *resty.Response
+		err      error
+		out      bool
+	}{
+		{restyResponse(http.MethodGet, http.StatusOK), nil, false},
+		{restyResponse(http.MethodPost, http.StatusTooManyRequests), nil, true},
+		{restyResponse(http.MethodPost, http.StatusServiceUnavailable), nil, true},
+		{restyResponse(http.MethodGet, http.StatusBadGateway), nil, true},
+		{restyResponse(http.MethodPost, http.StatusBadGateway), nil, false},
+		{restyResponse(http.MethodDelete, http.StatusGatewayTimeout), nil, true},
+		{restyResponse(http.MethodPut, http.StatusInternalServerError), nil, false},
+		{restyResponse(http.MethodGet, http.StatusNotFound), nil, false},
+		{restyResponse(http.MethodGet, 0), connectionReset, true},
+		{restyResponse(http.MethodPost, 0), connectionReset, false},
+		{nil, connectionReset, false},
+	}
+
+	for _, test := range tests {
+		out := isRetryableResponse(test.response, test.err)
+		if out != test.out {
+			t.Errorf("Mismatch on val %#v: expected %#v but got %#v", test.response, test.out, out)
+		}
+	}
+}
+
+func TestConfigRetryAfterFromHeader(t *testing.T) {
+	tests := []struct {
+		val string
+		out time.Duration
+	}{
+		{"", 0},
+		{"10", 10 * time.Second},
+		{"Wed, 21 Oct 2015 07:28:00 GMT", 0},
+		{"soon", 0},
+	}
+
+	for _, test := range tests {
+		response := &resty.Response{RawResponse: &http.Response{Header: http.Header{}}}
+		if test.val != "" {
+			response.RawResponse.Header.Set("Retry-After", test.val)
+		}
+		out, err := retryAfterFromHeader(nil, response)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if out != test.out {
+			t.Errorf("Mismatch on val %#v: expected %#v but got %#v", test.val, test.out, out)
+		}
+	}
+}
+
+func TestConfigRetryWithoutMinWait(t *testing.T) {
+	calls := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"SearchResult":{"total":0,"resources":[]}}`))
+	}))
+	t.Cleanup(server.Close)
+	config := Config{BaseURL: server.URL, Username: "admin", Password: "password", SSLVerify: "false", UseAPIGateway: "true", UseCSRFToken: "false", MaxRetries: 1, RetryMinWait: 0, RetryMaxWait: 0}
+	client, err := config.NewClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, _, err := client.SecurityGroups.GetSecurityGroups(nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the 503 to be retried once, got %d calls", calls)
+	}
+}
@@ -107,12 +107,18 @@ func responseInterfaceToSliceString(v interface{}) []string {
 		return nil
 	}
 	for _, i := range value {
-		newValue = append(newValue, responseInterfaceToString(i))
+		newValue = append(newValue, interfaceToJSONString(i))
 	}
 	return newValue
 }
 
+// responseInterfaceToString returns v as JSON for the logs, with the values of
+// secret keys redacted.
 func responseInterfaceToString(v interface{}) string {
+	return redactSecrets(interfaceToJSONString(v))
+}
+
+func interfaceToJSONString(v interface{}) string {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return fmt.Sprint(v)
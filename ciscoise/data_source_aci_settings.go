@@ -60,6 +60,7 @@ func dataSourceAciSettings() *schema.Resource {
 						"admin_password": &schema.Schema{
 							Description: `ACI Cluster Admin password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 						},
 						"all_sxp_domain": &schema.Schema{
@@ -153,7 +154,7 @@ func dataSourceAciSettingsRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAciSettings", err,
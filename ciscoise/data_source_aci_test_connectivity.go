@@ -52,7 +52,7 @@ func dataSourceAciTestConnectivityRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing TestAciConnectivity", err,
@@ -721,7 +721,7 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetActiveDirectory", err,
@@ -771,7 +771,7 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetActiveDirectoryByName", err,
@@ -800,7 +800,7 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetActiveDirectoryByID", err,
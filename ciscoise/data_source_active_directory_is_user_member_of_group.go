@@ -101,7 +101,7 @@ func dataSourceActiveDirectoryIsUserMemberOfGroupRead(ctx context.Context, d *sc
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing IsUserMemberOfGroups", err,
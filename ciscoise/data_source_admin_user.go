@@ -271,7 +271,7 @@ func dataSourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAdminUsers", err,
@@ -321,7 +321,7 @@ func dataSourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAdminUserByID", err,
@@ -1069,7 +1069,7 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAllowedProtocols", err,
@@ -1119,7 +1119,7 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAllowedProtocolByName", err,
@@ -1148,7 +1148,7 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAllowedProtocolByID", err,
@@ -225,7 +225,7 @@ func dataSourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAncEndpoint", err,
@@ -275,7 +275,7 @@ func dataSourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAncEndpointByID", err,
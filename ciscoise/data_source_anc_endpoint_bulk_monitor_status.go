@@ -111,7 +111,7 @@ func dataSourceAncEndpointBulkMonitorStatusRead(ctx context.Context, d *schema.R
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing MonitorBulkStatusAncEndpoint", err,
@@ -295,7 +295,7 @@ func dataSourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAncPolicy", err,
@@ -345,7 +345,7 @@ func dataSourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAncPolicyByName", err,
@@ -374,7 +374,7 @@ func dataSourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAncPolicyByID", err,
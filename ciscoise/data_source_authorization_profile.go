@@ -673,7 +673,7 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAuthorizationProfiles", err,
@@ -723,7 +723,7 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAuthorizationProfileByName", err,
@@ -752,7 +752,7 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAuthorizationProfileByID", err,
@@ -247,7 +247,7 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateProfile", err,
@@ -297,7 +297,7 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateProfileByName", err,
@@ -326,7 +326,7 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateProfileByID", err,
@@ -460,7 +460,7 @@ func dataSourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeploymentInfo", err,
@@ -540,7 +540,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemNetworkAccessInfoNodeListNode
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
@@ -594,7 +594,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemProfilerInfoNodeListNodeProfi
 	}
 	respItem := *item
 
-	return responseInterfaceToString(respItem)
+	return interfaceToJSONString(respItem)
 
 }
 
@@ -667,7 +667,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemDeploymentInfoNodeListNodeAnd
 	}
 	respItem := *item
 
-	return responseInterfaceToString(respItem)
+	return interfaceToJSONString(respItem)
 
 }
 
@@ -705,7 +705,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemNadInfoNodeListNodeAndScope(i
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
@@ -760,7 +760,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemMdmInfoNodeListNodeAndScope(i
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
@@ -799,7 +799,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemLicensesInfoNodeListNode(item
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
@@ -842,7 +842,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemPostureInfoContentValue(item
 	}
 	respItem := *item
 
-	return responseInterfaceToString(respItem)
+	return interfaceToJSONString(respItem)
 
 }
 
@@ -923,7 +923,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemKongInfoNodeListNodeServiceRo
 	}
 	respItem := *item
 
-	return responseInterfaceToString(respItem)
+	return interfaceToJSONString(respItem)
 
 }
 
@@ -933,7 +933,7 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemKongInfoNodeListNodeServiceRo
 	}
 	respItem := *item
 
-	return responseInterfaceToString(respItem)
+	return interfaceToJSONString(respItem)
 
 }
 
@@ -943,6 +943,6 @@ func flattenPullDeploymentInfoGetDeploymentInfoItemKongInfoNodeListNodeServiceRo
 	}
 	respItem := *item
 
-	return responseInterfaceToString(respItem)
+	return interfaceToJSONString(respItem)
 
 }
@@ -633,7 +633,7 @@ func dataSourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminPolicySetGlobalExceptionRules", err,
@@ -662,7 +662,7 @@ func dataSourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context,
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminPolicySetGlobalExceptionByRuleID", err,
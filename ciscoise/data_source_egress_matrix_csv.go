@@ -0,0 +1,74 @@
+package ciscoise
+
+import (
+	"context"
+
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEgressMatrixCSV() *schema.Resource {
+	return &schema.Resource{
+		Description: `It renders the TrustSec egress matrix in the egress policy CSV format of the ISE GUI export.
+
+- Reads all the egress matrix cells, the ANY-ANY default cell included, and resolves their security groups and security group ACLs to names.
+
+- The CSV has the Source SGT, Destination SGT, SGACL Name and Rule Status columns, one row per cell ordered by cell name. SGACL Name lists the security group ACLs separated by semicolons, followed by Permit IP or Deny IP for the default rule. A cell without default rule whose last security group ACL is named Permit IP or Deny IP fails the read, since it would be read back with that default rule.
+
+- The CSV can be given as is to the cells_csv parameter of ciscoise_egress_matrix.
+`,
+
+		ReadContext: dataSourceEgressMatrixCSVRead,
+		Schema: map[string]*schema.Schema{
+			"csv": &schema.Schema{
+				Description: `Egress matrix in the egress policy CSV format`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceEgressMatrixCSVRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Selected method: GetEgressMatrixCell")
+	cells, restyResp1, err := readEgressMatrixCells(m, nil)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing GetEgressMatrixCell", err, restyResp1))
+		return diags
+	}
+	names, err := readEgressMatrixNames(m)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when resolving EgressMatrixCell names", err))
+		return diags
+	}
+	rows := []egressMatrixCSVCell{}
+	for _, cell := range cells {
+		row, err := names.csvCell(cell)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when resolving EgressMatrixCell names", err))
+			return diags
+		}
+		rows = append(rows, row)
+	}
+	vCSV, err := renderEgressMatrixCSV(rows)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when rendering EgressMatrixCSV", err))
+		return diags
+	}
+	if err := d.Set("csv", vCSV); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting EgressMatrixCSV response",
+			err))
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	return diags
+}
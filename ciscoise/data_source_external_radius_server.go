@@ -63,8 +63,9 @@ func dataSourceExternalRadiusServer() *schema.Resource {
 						"authenticator_key": &schema.Schema{
 							Description: `The authenticatorKey is required only if enableKeyWrap is true, otherwise it must be ignored or empty.
 The maximum length is 20 ASCII characters or 40 HEXADECIMAL characters (depend on selection in field 'keyInputFormat')`,
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"description": &schema.Schema{
 							Type:     schema.TypeString,
@@ -79,8 +80,9 @@ When running in FIPS mode this option should be enabled for such devices`,
 						"encryption_key": &schema.Schema{
 							Description: `The encryptionKey is required only if enableKeyWrap is true, otherwise it must be ignored or empty.
 The maximum length is 16 ASCII characters or 32 HEXADECIMAL characters (depend on selection in field 'keyInputFormat')`,
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"host_ip": &schema.Schema{
 							Description: `The IP of the host - must be a valid IPV4 address`,
@@ -138,6 +140,7 @@ Allowed Values:
 						"shared_secret": &schema.Schema{
 							Description: `Shared secret maximum length is 128 characters`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 						},
 						"timeout": &schema.Schema{
@@ -167,8 +170,9 @@ Allowed Values:
 						"authenticator_key": &schema.Schema{
 							Description: `The authenticatorKey is required only if enableKeyWrap is true, otherwise it must be ignored or empty.
 The maximum length is 20 ASCII characters or 40 HEXADECIMAL characters (depend on selection in field 'keyInputFormat')`,
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"description": &schema.Schema{
 							Type:     schema.TypeString,
@@ -183,8 +187,9 @@ When running in FIPS mode this option should be enabled for such devices`,
 						"encryption_key": &schema.Schema{
 							Description: `The encryptionKey is required only if enableKeyWrap is true, otherwise it must be ignored or empty.
 The maximum length is 16 ASCII characters or 32 HEXADECIMAL characters (depend on selection in field 'keyInputFormat')`,
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"host_ip": &schema.Schema{
 							Description: `The IP of the host - must be a valid IPV4 address`,
@@ -242,6 +247,7 @@ Allowed Values:
 						"shared_secret": &schema.Schema{
 							Description: `Shared secret maximum length is 128 characters`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 						},
 						"timeout": &schema.Schema{
@@ -331,7 +337,7 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetExternalRadiusServer", err,
@@ -381,7 +387,7 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetExternalRadiusServerByName", err,
@@ -410,7 +416,7 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetExternalRadiusServerByID", err,
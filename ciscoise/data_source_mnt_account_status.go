@@ -54,7 +54,7 @@ func dataSourceMntAccountStatusRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetAccountStatusByMac", err, response1.String(),
 					"Failure at GetAccountStatusByMac, unexpected response", ""))
@@ -66,7 +66,7 @@ func dataSourceMntAccountStatusRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
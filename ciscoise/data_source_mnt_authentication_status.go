@@ -61,7 +61,7 @@ func dataSourceMntAuthenticationStatusRead(ctx context.Context, d *schema.Resour
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetAuthenticationStatusByMac", err, response1.String(),
 					"Failure at GetAuthenticationStatusByMac, unexpected response", ""))
@@ -73,7 +73,7 @@ func dataSourceMntAuthenticationStatusRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
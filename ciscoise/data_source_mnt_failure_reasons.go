@@ -40,7 +40,7 @@ func dataSourceMntFailureReasonsRead(ctx context.Context, d *schema.ResourceData
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetFailureReasons", err, response1.String(),
 					"Failure at GetFailureReasons, unexpected response", ""))
@@ -52,7 +52,7 @@ func dataSourceMntFailureReasonsRead(ctx context.Context, d *schema.ResourceData
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -47,7 +47,7 @@ func dataSourceMntSessionByIPRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetSessionsByEndpointIP", err, response1.String(),
 					"Failure at GetSessionsByEndpointIP, unexpected response", ""))
@@ -59,7 +59,7 @@ func dataSourceMntSessionByIPRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
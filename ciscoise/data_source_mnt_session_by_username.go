@@ -47,7 +47,7 @@ func dataSourceMntSessionByUsernameRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetSessionsByUsername", err, response1.String(),
 					"Failure at GetSessionsByUsername, unexpected response", ""))
@@ -59,7 +59,7 @@ func dataSourceMntSessionByUsernameRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -75,7 +75,7 @@ func dataSourceMntSessionDisconnectRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SessionDisconnect", err, response1.String(),
 					"Failure at SessionDisconnect, unexpected response", ""))
@@ -87,7 +87,7 @@ func dataSourceMntSessionDisconnectRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -61,7 +61,7 @@ func dataSourceMntSessionReauthenticationRead(ctx context.Context, d *schema.Res
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SessionReauthenticationByMac", err, response1.String(),
 					"Failure at SessionReauthenticationByMac, unexpected response", ""))
@@ -73,7 +73,7 @@ func dataSourceMntSessionReauthenticationRead(ctx context.Context, d *schema.Res
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -47,7 +47,7 @@ func dataSourceMntSessionsBySessionIDRead(ctx context.Context, d *schema.Resourc
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetSessionsBySessionID", err, response1.String(),
 					"Failure at GetSessionsBySessionID, unexpected response", ""))
@@ -59,7 +59,7 @@ func dataSourceMntSessionsBySessionIDRead(ctx context.Context, d *schema.Resourc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -673,7 +673,7 @@ func dataSourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetMyDevicePortal", err,
@@ -723,7 +723,7 @@ func dataSourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetMyDevicePortalByID", err,
@@ -860,7 +860,7 @@ func flattenMyDevicePortalGetMyDevicePortalByIDItemSettingsLoginPageSettingsSoci
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
@@ -191,7 +191,7 @@ func dataSourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resour
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNativeSupplicantProfile", err,
@@ -241,7 +241,7 @@ func dataSourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resour
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNativeSupplicantProfileByID", err,
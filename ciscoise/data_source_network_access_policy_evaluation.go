@@ -0,0 +1,225 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkAccessPolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Description: `It evaluates the Network Access policy of ISE against a set of attributes, without sending a request to ISE.
+- Fetches the policy sets, authentication rules, authorization rules, local and global exception rules and library conditions, and evaluates them in rank order on the client.
+- Disabled rules and rules in monitor mode are skipped. Attributes that are not given do not match.
+- Conditions that can not be evaluated, such as time and date conditions, MAC address operators or attributes compared to other attributes, are reported as indeterminate. The first indeterminate policy set or rule stops the evaluation, since it may match before the others.
+`,
+
+		ReadContext: dataSourceNetworkAccessPolicyEvaluationRead,
+		Schema: map[string]*schema.Schema{
+			"attributes": &schema.Schema{
+				Description: `Attributes of the request, keyed by Dictionary:Attribute, such as Radius:Called-Station-ID, EndPoints:LogicalProfile or IdentityGroup:Name`,
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"item": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"authentication_rule_id": &schema.Schema{
+							Description: `Id of the matched authentication rule`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"authentication_rule_name": &schema.Schema{
+							Description: `Name of the matched authentication rule`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"authorization_rule_id": &schema.Schema{
+							Description: `Id of the matched authorization or exception rule`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"authorization_rule_name": &schema.Schema{
+							Description: `Name of the matched authorization or exception rule`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"authorization_rule_type": &schema.Schema{
+							Description: `Type of the matched authorization rule: local_exception, global_exception or authorization`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"identity_source_name": &schema.Schema{
+							Description: `Identity source of the matched authentication rule`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"indeterminate_reasons": &schema.Schema{
+							Description: `Why the evaluation is indeterminate, one entry per policy set or rule that could not be evaluated`,
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"policy_set_id": &schema.Schema{
+							Description: `Id of the matched policy set`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"policy_set_name": &schema.Schema{
+							Description: `Name of the matched policy set`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"profiles": &schema.Schema{
+							Description: `Authorization profiles of the matched authorization rule`,
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"result": &schema.Schema{
+							Description: `matched when an authorization rule matched, indeterminate when a policy set or rule could not be evaluated, or no_match`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"security_group": &schema.Schema{
+							Description: `Security group of the matched authorization rule`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkAccessPolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+	attributes := map[string]string{}
+	for k, v := range d.Get("attributes").(map[string]interface{}) {
+		attributes[k] = interfaceToString(v)
+	}
+
+	log.Printf("[DEBUG] Selected method: GetNetworkAccessConditions")
+	_, restyResp1, err := client.NetworkAccessConditions.GetNetworkAccessConditions()
+	conditions, err := decodePolicyObjects(restyResp1, err)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing GetNetworkAccessConditions", err, restyResp1))
+		return diags
+	}
+	evaluator := newPolicyEvaluator(attributes, conditions)
+
+	log.Printf("[DEBUG] Selected method: GetNetworkAccessPolicySets")
+	_, restyResp2, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySets()
+	policySets, err := decodePolicyObjects(restyResp2, err)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing GetNetworkAccessPolicySets", err, restyResp2))
+		return diags
+	}
+
+	item := map[string]interface{}{"result": "no_match"}
+	reasons := []string{}
+	addReason := func(kind string, match *policyEvaluationMatch) {
+		if match.result == conditionIndeterminate {
+			reasons = append(reasons, fmt.Sprintf("%s %s: %s", kind, conditionString(match.object, "name"), match.reason))
+		}
+	}
+	policySet := evaluator.evaluatePolicyObjects(policySets)
+	if policySet != nil {
+		item["policy_set_id"] = conditionString(policySet.object, "id")
+		item["policy_set_name"] = conditionString(policySet.object, "name")
+		addReason("policy set", policySet)
+	}
+	if policySet != nil && policySet.result == conditionTrue {
+		policyID := conditionString(policySet.object, "id")
+
+		log.Printf("[DEBUG] Selected method: GetNetworkAccessAuthenticationRules")
+		_, restyResp3, err := client.NetworkAccessAuthenticationRules.GetNetworkAccessAuthenticationRules(policyID)
+		authenticationRules, err := decodePolicyObjects(restyResp3, err)
+		if err != nil {
+			diags = append(diags, diagErrorWithOptionalResponse(
+				"Failure when executing GetNetworkAccessAuthenticationRules", err, restyResp3))
+			return diags
+		}
+		if rule := evaluator.evaluatePolicyObjects(policyRuleObjects(authenticationRules)); rule != nil {
+			item["authentication_rule_id"] = conditionString(rule.object, "id")
+			item["authentication_rule_name"] = conditionString(rule.object, "name")
+			item["identity_source_name"] = conditionString(rule.object, "identitySourceName")
+			addReason("authentication rule", rule)
+		}
+
+		authorizationRules := []struct {
+			ruleType string
+			list     func() (*resty.Response, error)
+		}{
+			{"local_exception", func() (*resty.Response, error) {
+				_, restyResp, err := client.NetworkAccessAuthorizationExceptionRules.GetNetworkAccessLocalExceptionRules(policyID)
+				return restyResp, err
+			}},
+			{"global_exception", func() (*resty.Response, error) {
+				_, restyResp, err := client.NetworkAccessAuthorizationGlobalExceptionRules.GetNetworkAccessPolicySetGlobalExceptionRules()
+				return restyResp, err
+			}},
+			{"authorization", func() (*resty.Response, error) {
+				_, restyResp, err := client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRules(policyID)
+				return restyResp, err
+			}},
+		}
+		for _, rules := range authorizationRules {
+			log.Printf("[DEBUG] Evaluating the %s rules", rules.ruleType)
+			restyResp, err := rules.list()
+			entries, err := decodePolicyObjects(restyResp, err)
+			if err != nil {
+				diags = append(diags, diagErrorWithOptionalResponse(
+					fmt.Sprintf("Failure when reading the %s rules", rules.ruleType), err, restyResp))
+				return diags
+			}
+			rule := evaluator.evaluatePolicyObjects(policyRuleObjects(entries))
+			if rule == nil {
+				continue
+			}
+			item["authorization_rule_id"] = conditionString(rule.object, "id")
+			item["authorization_rule_name"] = conditionString(rule.object, "name")
+			item["authorization_rule_type"] = rules.ruleType
+			addReason("authorization rule", rule)
+			if rule.result == conditionTrue {
+				item["profiles"] = interfaceToSliceString(rule.object["profile"])
+				item["security_group"] = conditionString(rule.object, "securityGroup")
+				item["result"] = "matched"
+			}
+			break
+		}
+	}
+	if len(reasons) > 0 {
+		item["result"] = "indeterminate"
+	}
+	item["indeterminate_reasons"] = reasons
+
+	if err := d.Set("item", []map[string]interface{}{item}); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting NetworkAccessPolicyEvaluation response",
+			err))
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	return diags
+}
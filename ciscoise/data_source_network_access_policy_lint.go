@@ -0,0 +1,136 @@
+package ciscoise
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkAccessPolicyLint() *schema.Resource {
+	return &schema.Resource{
+		Description: `It analyses the authorization rules of a Network Access policy set.
+- shadowed (warning): an earlier rule matches every request the rule matches, so it can never match. Conditions are compared with the library conditions inlined, and only implications that can be proven are reported.
+- duplicate_condition (warning): an earlier rule has the same condition.
+- missing_condition (error): the rule references a library condition that does not exist.
+- disabled_condition (warning): the rule references a time and date condition whose date range has ended. Library conditions have no state in the ISE API.
+- unused (info): the rule is enabled and its hit_counts is zero.
+`,
+
+		ReadContext: dataSourceNetworkAccessPolicyLintRead,
+		Schema: map[string]*schema.Schema{
+			"policy_id": &schema.Schema{
+				Description: `policyId path parameter. Policy id`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"items": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"message": &schema.Schema{
+							Description: `Description of the finding`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rank": &schema.Schema{
+							Description: `Rank of the rule`,
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"related_rule_id": &schema.Schema{
+							Description: `Id of the earlier rule of shadowed and duplicate_condition findings`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"related_rule_name": &schema.Schema{
+							Description: `Name of the earlier rule of shadowed and duplicate_condition findings`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rule_id": &schema.Schema{
+							Description: `Id of the rule`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rule_name": &schema.Schema{
+							Description: `Name of the rule`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"severity": &schema.Schema{
+							Description: `error, warning or info`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": &schema.Schema{
+							Description: `shadowed, duplicate_condition, missing_condition, disabled_condition or unused`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkAccessPolicyLintRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+	vvPolicyID := d.Get("policy_id").(string)
+
+	log.Printf("[DEBUG] Selected method: GetNetworkAccessConditions")
+	_, restyResp1, err := client.NetworkAccessConditions.GetNetworkAccessConditions()
+	conditions, err := decodePolicyObjects(restyResp1, err)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing GetNetworkAccessConditions", err, restyResp1))
+		return diags
+	}
+
+	log.Printf("[DEBUG] Selected method: GetNetworkAccessAuthorizationRules")
+	_, restyResp2, err := client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRules(vvPolicyID)
+	entries, err := decodePolicyObjects(restyResp2, err)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing GetNetworkAccessAuthorizationRules", err, restyResp2))
+		return diags
+	}
+
+	findings := lintPolicyRules(policyRuleObjects(entries), conditions, time.Now())
+	log.Printf("[DEBUG] Found %d issues in the authorization rules of %s", len(findings), vvPolicyID)
+	if err := d.Set("items", flattenPolicyLintFindings(findings)); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting NetworkAccessPolicyLint response",
+			err))
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	return diags
+}
+
+func flattenPolicyLintFindings(findings []policyLintFinding) []map[string]interface{} {
+	var respItems []map[string]interface{}
+	for _, finding := range findings {
+		respItem := make(map[string]interface{})
+		respItem["rule_id"] = conditionString(finding.rule, "id")
+		respItem["rule_name"] = conditionString(finding.rule, "name")
+		if rank, ok := finding.rule["rank"].(float64); ok {
+			respItem["rank"] = int(rank)
+		}
+		respItem["type"] = finding.findingType
+		respItem["severity"] = finding.severity
+		respItem["related_rule_id"] = conditionString(finding.related, "id")
+		respItem["related_rule_name"] = conditionString(finding.related, "name")
+		respItem["message"] = finding.message
+		respItems = append(respItems, respItem)
+	}
+	return respItems
+}
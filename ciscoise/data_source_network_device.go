@@ -169,8 +169,9 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"key_encryption_key": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"key_input_format": &schema.Schema{
 										Description: `Allowed values:
@@ -180,8 +181,9 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"message_authenticator_code_key": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"network_protocol": &schema.Schema{
 										Description: `Allowed values:
@@ -191,12 +193,14 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"radius_shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"second_radius_shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 								},
 							},
@@ -274,8 +278,9 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"ro_community": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"version": &schema.Schema{
 										Type:     schema.TypeString,
@@ -303,8 +308,9 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 								},
 							},
@@ -326,8 +332,9 @@ string parameter. Each resource Data model description should specify if an attr
 													Computed: true,
 												},
 												"sga_device_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 											},
 										},
@@ -339,12 +346,14 @@ string parameter. Each resource Data model description should specify if an attr
 											Schema: map[string]*schema.Schema{
 
 												"enable_mode_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 												"exec_mode_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 												"exec_mode_username": &schema.Schema{
 													Type:     schema.TypeString,
@@ -473,8 +482,9 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"key_encryption_key": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"key_input_format": &schema.Schema{
 										Description: `Allowed values:
@@ -484,8 +494,9 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"message_authenticator_code_key": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"network_protocol": &schema.Schema{
 										Description: `Allowed values:
@@ -495,12 +506,14 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"radius_shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"second_radius_shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 								},
 							},
@@ -578,8 +591,9 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"ro_community": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"version": &schema.Schema{
 										Type:     schema.TypeString,
@@ -607,8 +621,9 @@ string parameter. Each resource Data model description should specify if an attr
 										Computed: true,
 									},
 									"shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 								},
 							},
@@ -630,8 +645,9 @@ string parameter. Each resource Data model description should specify if an attr
 													Computed: true,
 												},
 												"sga_device_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 											},
 										},
@@ -643,12 +659,14 @@ string parameter. Each resource Data model description should specify if an attr
 											Schema: map[string]*schema.Schema{
 
 												"enable_mode_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 												"exec_mode_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 												"exec_mode_username": &schema.Schema{
 													Type:     schema.TypeString,
@@ -811,7 +829,7 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkDevice", err,
@@ -861,7 +879,7 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkDeviceByName", err,
@@ -890,7 +908,7 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkDeviceByID", err,
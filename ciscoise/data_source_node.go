@@ -170,8 +170,9 @@ string parameter. Each resource Data model description should specify if an attr
 							Computed: true,
 						},
 						"pass_word": &schema.Schema{
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"primary_pap_node": &schema.Schema{
 							Type:     schema.TypeString,
@@ -267,8 +268,9 @@ string parameter. Each resource Data model description should specify if an attr
 							Computed: true,
 						},
 						"pass_word": &schema.Schema{
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"primary_pap_node": &schema.Schema{
 							Type:     schema.TypeString,
@@ -376,7 +378,7 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeDetails", err,
@@ -426,7 +428,7 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeDetailByName", err,
@@ -455,7 +457,7 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeDetailByID", err,
@@ -263,7 +263,7 @@ func dataSourceNodeServicesProfilerProbeConfigRead(ctx context.Context, d *schem
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetProfilerProbeConfig", err,
@@ -447,7 +447,7 @@ func flattenNodeServicesGetProfilerProbeConfigItemNmap(items *[]isegosdk.Respons
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
@@ -459,7 +459,7 @@ func flattenNodeServicesGetProfilerProbeConfigItemPxgrid(items *[]isegosdk.Respo
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
@@ -471,7 +471,7 @@ func flattenNodeServicesGetProfilerProbeConfigItemRadius(items *[]isegosdk.Respo
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
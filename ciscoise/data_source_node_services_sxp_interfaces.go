@@ -58,7 +58,7 @@ func dataSourceNodeServicesSxpInterfacesRead(ctx context.Context, d *schema.Reso
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSxpInterface", err,
@@ -90,7 +90,7 @@ func dataSourcePanHaRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPanHaStatus", err,
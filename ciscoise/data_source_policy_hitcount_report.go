@@ -0,0 +1,276 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePolicyHitcountReport() *schema.Resource {
+	return &schema.Resource{
+		Description: `It reads the hit counts of the Network Access and Device Administration policy rules.
+- Walks all the policy sets and returns the hit counts of their authentication, local exception and authorization rules, then of the global exception rules, with totals per policy set.
+- Filter the rules with min_hits, max_hits and policy_set_name, for instance max_hits = 0 to find the rules never matched since the last reset.
+- ISE does not report when hit counts were reset. Set last_reset, such as the last_updated attribute of a *_reset_hitcount resource, to get time_since_reset.
+`,
+
+		ReadContext: dataSourcePolicyHitcountReportRead,
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Description:  `Policy domain to report, network_access or device_administration. Both when not set.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringHasValueFunc([]string{"network_access", "device_administration"}),
+			},
+			"last_reset": &schema.Schema{
+				Description: `Time of the last hit count reset, as a Unix timestamp or in RFC 3339 format`,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"max_hits": &schema.Schema{
+				Description:  `Only report the rules with at most this number of hits`,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerGeqThan(0),
+			},
+			"min_hits": &schema.Schema{
+				Description:  `Only report the rules with at least this number of hits`,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateIntegerGeqThan(0),
+			},
+			"policy_set_name": &schema.Schema{
+				Description: `Only report the rules of the policy sets with this name. Global exception rules are left out.`,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"time_since_reset": &schema.Schema{
+				Description: `Time elapsed since last_reset, such as 720h0m0s. Empty when last_reset is not set.`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"items": &schema.Schema{
+				Description: `Rules matching the filters`,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"default": &schema.Schema{
+							Description: `Whether the rule is the default rule of its policy set`,
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"domain": &schema.Schema{
+							Description: `network_access or device_administration`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"hit_counts": &schema.Schema{
+							Description: `Hits of the rule since the last reset`,
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"policy_set_id": &schema.Schema{
+							Description: `Policy set id, empty for global exception rules`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"policy_set_name": &schema.Schema{
+							Description: `Policy set name, empty for global exception rules`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rank": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rule_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_type": &schema.Schema{
+							Description: `authentication, local_exception, authorization or global_exception`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"state": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy_sets": &schema.Schema{
+				Description: `Policy sets by rank, with the totals of all their rules`,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"domain": &schema.Schema{
+							Description: `network_access or device_administration`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"hit_counts": &schema.Schema{
+							Description: `Hits of the policy set since the last reset`,
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_hit_counts": &schema.Schema{
+							Description: `Sum of the hits of the rules of the policy set, before the min_hits and max_hits filters`,
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"rules": &schema.Schema{
+							Description: `Number of rules of the policy set, default rules included`,
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"state": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicyHitcountReportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+	filter := policyHitCountFilter{
+		policySetName: interfaceToString(d.Get("policy_set_name")),
+	}
+	if v, ok := d.GetOkExists("min_hits"); ok {
+		minHits := v.(int)
+		filter.minHits = &minHits
+	}
+	if v, ok := d.GetOkExists("max_hits"); ok {
+		maxHits := v.(int)
+		filter.maxHits = &maxHits
+	}
+	timeSinceReset := ""
+	if v, ok := d.GetOk("last_reset"); ok {
+		lastReset, err := parsePolicyHitCountReset(interfaceToString(v))
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when parsing last_reset", err))
+			return diags
+		}
+		timeSinceReset = time.Since(lastReset).Round(time.Second).String()
+	}
+
+	vItems := []map[string]interface{}{}
+	vPolicySets := []map[string]interface{}{}
+	for _, domain := range []policySnapshotDomain{
+		networkAccessPolicySnapshotDomain(client),
+		deviceAdministrationPolicySnapshotDomain(client),
+	} {
+		if v, ok := d.GetOk("domain"); ok && interfaceToString(v) != domain.name {
+			continue
+		}
+		log.Printf("[DEBUG] Reading the %s hit counts", domain.name)
+		sets, rules, err := domain.hitCounts(filter)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when reading the PolicyHitcountReport", err))
+			return diags
+		}
+		vItems = append(vItems, flattenPolicyHitCountRules(rules)...)
+		vPolicySets = append(vPolicySets, flattenPolicyHitCountSets(sets)...)
+	}
+
+	if err := d.Set("items", vItems); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting PolicyHitcountReport response",
+			err))
+		return diags
+	}
+	if err := d.Set("policy_sets", vPolicySets); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting PolicyHitcountReport response",
+			err))
+		return diags
+	}
+	if err := d.Set("time_since_reset", timeSinceReset); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting PolicyHitcountReport response",
+			err))
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	return diags
+}
+
+// parsePolicyHitCountReset parses a Unix timestamp, such as the last_updated
+// attribute of the resources, or an RFC 3339 time.
+func parsePolicyHitCountReset(value string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected a Unix timestamp or an RFC 3339 time, got %q", value)
+	}
+	return t, nil
+}
+
+func flattenPolicyHitCountRules(rules []policyHitCountRule) []map[string]interface{} {
+	var respItems []map[string]interface{}
+	for _, rule := range rules {
+		respItem := make(map[string]interface{})
+		respItem["domain"] = rule.domain
+		respItem["policy_set_id"] = rule.policySetID
+		respItem["policy_set_name"] = rule.policySetName
+		respItem["rule_type"] = rule.ruleType
+		respItem["rule_id"] = conditionString(rule.rule, "id")
+		respItem["rule_name"] = conditionString(rule.rule, "name")
+		if rank, ok := rule.rule["rank"].(float64); ok {
+			respItem["rank"] = int(rank)
+		}
+		respItem["state"] = conditionString(rule.rule, "state")
+		respItem["default"] = rule.rule["default"] == true
+		respItem["hit_counts"] = policyObjectHitCounts(rule.rule)
+		respItems = append(respItems, respItem)
+	}
+	return respItems
+}
+
+func flattenPolicyHitCountSets(sets []policyHitCountSet) []map[string]interface{} {
+	var respItems []map[string]interface{}
+	for _, set := range sets {
+		respItem := make(map[string]interface{})
+		respItem["domain"] = set.domain
+		respItem["id"] = conditionString(set.policySet, "id")
+		respItem["name"] = conditionString(set.policySet, "name")
+		respItem["state"] = conditionString(set.policySet, "state")
+		respItem["hit_counts"] = policyObjectHitCounts(set.policySet)
+		respItem["rules"] = set.rules
+		respItem["rule_hit_counts"] = set.ruleHits
+		respItems = append(respItems, respItem)
+	}
+	return respItems
+}
@@ -0,0 +1,77 @@
+package ciscoise
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePolicySnapshot() *schema.Resource {
+	return &schema.Resource{
+		Description: `It reads the whole Network Access and Device Administration policy as one canonical JSON document.
+- Includes the policy sets with their authentication, authorization and local exception rules, the global exception rules and the library conditions of both domains.
+- Policy sets and rules are ordered by rank, library conditions by name, and object keys alphabetically. Volatile fields (link, hitCounts) are left out, so the document only changes when the policy does.
+- Compare two snapshots with the ciscoise_policy_snapshot_diff data source.
+`,
+
+		ReadContext: dataSourcePolicySnapshotRead,
+		Schema: map[string]*schema.Schema{
+			"checksum": &schema.Schema{
+				Description: `SHA-256 checksum of the snapshot`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"snapshot": &schema.Schema{
+				Description: `Canonical JSON document of the policy`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicySnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+	snapshot := map[string]interface{}{}
+	for _, domain := range []policySnapshotDomain{
+		networkAccessPolicySnapshotDomain(client),
+		deviceAdministrationPolicySnapshotDomain(client),
+	} {
+		log.Printf("[DEBUG] Reading the %s policy", domain.name)
+		policy, err := domain.snapshot()
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when reading the PolicySnapshot", err))
+			return diags
+		}
+		snapshot[domain.name] = policy
+	}
+	document, err := policySnapshotJSON(snapshot)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when encoding the PolicySnapshot", err))
+		return diags
+	}
+
+	if err := d.Set("snapshot", document); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting PolicySnapshot response",
+			err))
+		return diags
+	}
+	if err := d.Set("checksum", fmt.Sprintf("%x", sha256.Sum256([]byte(document)))); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting PolicySnapshot response",
+			err))
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	return diags
+}
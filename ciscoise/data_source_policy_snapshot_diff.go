@@ -0,0 +1,101 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePolicySnapshotDiff() *schema.Resource {
+	return &schema.Resource{
+		Description: `It compares two snapshots of the ciscoise_policy_snapshot data source.
+- Lists the elements added, removed and changed from old_snapshot to new_snapshot by path, such as network_access.policy_sets["Wireless"].authorization_rules["Guests"].profile.
+- Elements of lists are identified by name. Lists with unnamed elements are compared as a whole.
+`,
+
+		ReadContext: dataSourcePolicySnapshotDiffRead,
+		Schema: map[string]*schema.Schema{
+			"new_snapshot": &schema.Schema{
+				Description: `The newer snapshot`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"old_snapshot": &schema.Schema{
+				Description: `The older snapshot`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"items": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"change": &schema.Schema{
+							Description: `added, removed or changed`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"new_value": &schema.Schema{
+							Description: `JSON value in new_snapshot, empty for removed elements`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"old_value": &schema.Schema{
+							Description: `JSON value in old_snapshot, empty for added elements`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"path": &schema.Schema{
+							Description: `Path of the element`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicySnapshotDiffRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	snapshots := []interface{}{nil, nil}
+	for i, key := range []string{"old_snapshot", "new_snapshot"} {
+		if err := json.Unmarshal([]byte(d.Get(key).(string)), &snapshots[i]); err != nil {
+			diags = append(diags, diagError(
+				fmt.Sprintf("Failure when decoding %s", key), err))
+			return diags
+		}
+	}
+
+	changes := diffPolicySnapshots("", snapshots[0], snapshots[1])
+	if err := d.Set("items", flattenPolicySnapshotChanges(changes)); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting PolicySnapshotDiff response",
+			err))
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	return diags
+}
+
+func flattenPolicySnapshotChanges(changes []policySnapshotChange) []map[string]interface{} {
+	var respItems []map[string]interface{}
+	for _, change := range changes {
+		respItem := make(map[string]interface{})
+		respItem["path"] = change.path
+		respItem["change"] = change.change
+		if change.change != "added" {
+			respItem["old_value"] = interfaceToJSONString(change.oldValue)
+		}
+		if change.change != "removed" {
+			respItem["new_value"] = interfaceToJSONString(change.newValue)
+		}
+		respItems = append(respItems, respItem)
+	}
+	return respItems
+}
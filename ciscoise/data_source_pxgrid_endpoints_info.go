@@ -41,7 +41,7 @@ func dataSourcePxgridEndpointsInfoRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetEndpoints", err, response1.String(),
 					"Failure at GetEndpoints, unexpected response", ""))
@@ -53,7 +53,7 @@ func dataSourcePxgridEndpointsInfoRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -41,7 +41,7 @@ func dataSourcePxgridSecurityGroupACLsInfoRead(ctx context.Context, d *schema.Re
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetSecurityGroupACLs", err, response1.String(),
 					"Failure at GetSecurityGroupACLs, unexpected response", ""))
@@ -53,7 +53,7 @@ func dataSourcePxgridSecurityGroupACLsInfoRead(ctx context.Context, d *schema.Re
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -260,7 +260,7 @@ func dataSourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSecurityGroupsACL", err,
@@ -310,7 +310,7 @@ func dataSourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSecurityGroupsACLByID", err,
@@ -390,7 +390,7 @@ func flattenSecurityGroupsACLsGetSecurityGroupsACLByIDItemModelledContent(item *
 	}
 	respItem := *item
 
-	return responseInterfaceToString(respItem)
+	return interfaceToJSONString(respItem)
 
 }
 
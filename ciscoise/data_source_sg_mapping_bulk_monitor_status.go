@@ -115,7 +115,7 @@ func dataSourceSgMappingBulkMonitorStatusRead(ctx context.Context, d *schema.Res
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing MonitorBulkStatusIPToSgtMapping", err,
@@ -66,7 +66,7 @@ func dataSourceSgMappingDeployStatusInfoRead(ctx context.Context, d *schema.Reso
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeployStatusIPToSgtMapping", err,
@@ -259,7 +259,7 @@ func dataSourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSecurityGroups", err,
@@ -309,7 +309,7 @@ func dataSourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSecurityGroupByID", err,
@@ -390,7 +390,7 @@ func flattenSecurityGroupsGetSecurityGroupByIDItemDefaultSgACLs(items *[]isegosd
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
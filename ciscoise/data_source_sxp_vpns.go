@@ -223,7 +223,7 @@ func dataSourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSxpVpns", err,
@@ -273,7 +273,7 @@ func dataSourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSxpVpnByID", err,
@@ -96,6 +96,7 @@ func dataSourceTacacsExternalServers() *schema.Resource {
 						"shared_secret": &schema.Schema{
 							Description: `The server shared secret`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 						},
 						"single_connect": &schema.Schema{
@@ -163,6 +164,7 @@ func dataSourceTacacsExternalServers() *schema.Resource {
 						"shared_secret": &schema.Schema{
 							Description: `The server shared secret`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 						},
 						"single_connect": &schema.Schema{
@@ -257,7 +259,7 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsExternalServers", err,
@@ -307,7 +309,7 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsExternalServersByName", err,
@@ -336,7 +338,7 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp3.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsExternalServersByID", err,
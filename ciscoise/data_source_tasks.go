@@ -139,7 +139,7 @@ func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAllTaskStatus", err,
@@ -168,7 +168,7 @@ func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTaskStatus", err,
@@ -219,7 +219,7 @@ func flattenTasksGetAllTaskStatusItemsDetailStatus(items *[]isegosdk.ResponseIte
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
@@ -249,7 +249,7 @@ func flattenTasksGetTaskStatusItemDetailStatus(items *[]isegosdk.ResponseTasksGe
 	var respItems []interface{}
 	for _, item := range *items {
 		respItem := item
-		respItems = append(respItems, responseInterfaceToString(respItem))
+		respItems = append(respItems, interfaceToJSONString(respItem))
 	}
 	return respItems
 }
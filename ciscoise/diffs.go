@@ -64,3 +64,30 @@ func diffSuppressBooleans() schema.SchemaDiffSuppressFunc {
 		return true
 	}
 }
+
+func diffSuppressConditionJSON() schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if old == "" || new == "" {
+			return old == new
+		}
+		return conditionJSONEqual(old, new)
+	}
+}
+
+func diffSuppressConditionExpression() schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if old == "" || new == "" {
+			return old == new
+		}
+		return normalizeConditionExpression(old) == normalizeConditionExpression(new)
+	}
+}
+
+func diffSuppressSGACLContent() schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if new == "" {
+			return true
+		}
+		return normalizeSGACLContent(old) == normalizeSGACLContent(new)
+	}
+}
@@ -216,3 +216,122 @@ func TestDiffsDiffSuppressBooleans(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffsDiffSuppressConditionJSON(t *testing.T) {
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"same tree": {
+			Old:                testConditionJSON,
+			New:                testConditionJSON,
+			ExpectDiffSuppress: true,
+		},
+		"details added by ISE": {
+			Old:                `{"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X","link":{"href":"https://ise/c1"}}`,
+			New:                `{"conditionType": "ConditionReference", "id": "c1"}`,
+			ExpectDiffSuppress: true,
+		},
+		"new condition_json": {
+			Old:                "",
+			New:                testConditionJSON,
+			ExpectDiffSuppress: false,
+		},
+		"removed condition_json": {
+			Old:                testConditionJSON,
+			New:                "",
+			ExpectDiffSuppress: false,
+		},
+		"different tree": {
+			Old:                `{"conditionType":"ConditionReference","id":"c1"}`,
+			New:                `{"conditionType":"ConditionReference","id":"c2"}`,
+			ExpectDiffSuppress: false,
+		},
+	}
+	for tn, tc := range cases {
+		if diffSuppressConditionJSON()("key", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, '%s' => '%s' expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
+func TestDiffsDiffSuppressConditionExpression(t *testing.T) {
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"same expression": {
+			Old:                testConditionExpression,
+			New:                testConditionExpression,
+			ExpectDiffSuppress: true,
+		},
+		"formatting and keywords": {
+			Old:                `Radius:NAS-Port-Type EQUALS "Ethernet" AND NOT ref("Wired_802.1X")`,
+			New:                `"Radius":"NAS-Port-Type" equals "Ethernet"  and not (ref(Wired_802.1X))`,
+			ExpectDiffSuppress: true,
+		},
+		"new condition_expression": {
+			Old:                "",
+			New:                testConditionExpression,
+			ExpectDiffSuppress: false,
+		},
+		"different operator": {
+			Old:                `Radius:NAS-Port-Type EQUALS "Ethernet"`,
+			New:                `Radius:NAS-Port-Type NOT_EQUALS "Ethernet"`,
+			ExpectDiffSuppress: false,
+		},
+		"different grouping": {
+			Old:                `ref(A) AND ref(B) OR ref(C)`,
+			New:                `ref(A) AND (ref(B) OR ref(C))`,
+			ExpectDiffSuppress: false,
+		},
+	}
+	for tn, tc := range cases {
+		if diffSuppressConditionExpression()("key", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, '%s' => '%s' expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
+func TestDiffsDiffSuppressSGACLContent(t *testing.T) {
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"same content": {
+			Old:                "permit tcp dst eq 443\ndeny ip",
+			New:                "permit tcp dst eq 443\ndeny ip",
+			ExpectDiffSuppress: true,
+		},
+		"whitespace and case": {
+			Old:                "permit tcp dst eq 443\ndeny ip log",
+			New:                "  PERMIT tcp  dst EQ 443\r\n\nDeny IP Log\n",
+			ExpectDiffSuppress: true,
+		},
+		"not set": {
+			Old:                "permit ip",
+			New:                "",
+			ExpectDiffSuppress: true,
+		},
+		"different port": {
+			Old:                "permit tcp dst eq 443",
+			New:                "permit tcp dst eq 8443",
+			ExpectDiffSuppress: false,
+		},
+		"different order": {
+			Old:                "permit tcp dst eq 443\ndeny ip",
+			New:                "deny ip\npermit tcp dst eq 443",
+			ExpectDiffSuppress: false,
+		},
+		"remark case": {
+			Old:                "remark Allow web",
+			New:                "REMARK allow web",
+			ExpectDiffSuppress: false,
+		},
+	}
+	for tn, tc := range cases {
+		if diffSuppressSGACLContent()("key", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, '%s' => '%s' expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package ciscoise
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+// egressMatrixCSVHeader is the header of the egress policy CSV exported and
+// imported by the ISE GUI.
+var egressMatrixCSVHeader = []string{"Source SGT", "Destination SGT", "SGACL Name", "Rule Status"}
+
+// egressMatrixCSVDefaultRules are the final catch all rules written after the
+// SGACLs of the SGACL Name column, by default rule.
+var egressMatrixCSVDefaultRules = map[string]string{
+	"PERMIT_IP": "Permit IP",
+	"DENY_IP":   "Deny IP",
+}
+
+// egressMatrixCSVStatuses are the values of the Rule Status column, by
+// matrix cell status.
+var egressMatrixCSVStatuses = map[string]string{
+	"ENABLED":  "Enabled",
+	"DISABLED": "Disabled",
+	"MONITOR":  "Monitor",
+}
+
+// egressMatrixCSVCell is a row of the egress policy CSV, the cell with its
+// security groups and ACLs by name.
+type egressMatrixCSVCell struct {
+	sourceSgt        string
+	destinationSgt   string
+	sgacls           []string
+	defaultRule      string
+	matrixCellStatus string
+}
+
+// egressMatrixCSVDefaultRule returns the default rule written as name, or
+// an empty string when name is not a default rule.
+func egressMatrixCSVDefaultRule(name string) string {
+	for rule, ruleName := range egressMatrixCSVDefaultRules {
+		if strings.EqualFold(name, ruleName) {
+			return rule
+		}
+	}
+	return ""
+}
+
+// record returns the CSV row of the cell. The default rule is written after
+// the SGACLs, so a cell without default rule whose last SGACL is the Permit IP
+// or Deny IP SGACL can not be written, it would be read back with that default
+// rule instead.
+func (c egressMatrixCSVCell) record() ([]string, error) {
+	sgacls := append([]string{}, c.sgacls...)
+	if rule, ok := egressMatrixCSVDefaultRules[c.defaultRule]; ok {
+		sgacls = append(sgacls, rule)
+	} else if n := len(sgacls); n > 0 && egressMatrixCSVDefaultRule(sgacls[n-1]) != "" {
+		return nil, fmt.Errorf("cell %s/%s can not be written in CSV, it has no default rule and its last SGACL %s would be read back as the default rule", c.sourceSgt, c.destinationSgt, sgacls[n-1])
+	}
+	return []string{c.sourceSgt, c.destinationSgt, strings.Join(sgacls, ";"), egressMatrixCSVStatuses[c.matrixCellStatus]}, nil
+}
+
+// parseEgressMatrixCSV returns the cells of an egress policy CSV. Columns
+// are found by their header, so extra columns are ignored. SGACL Name and
+// Rule Status may be left out, for cells without SGACLs and enabled cells. A
+// last SGACL named Permit IP or Deny IP is always read as the default rule.
+func parseEgressMatrixCSV(content string) ([]egressMatrixCSVCell, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the CSV header: %v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, name := range egressMatrixCSVHeader[:2] {
+		if _, ok := columns[strings.ToLower(name)]; !ok {
+			return nil, fmt.Errorf("the CSV header has no %s column", name)
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[strings.ToLower(name)]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	cells := []egressMatrixCSVCell{}
+	keys := map[string]bool{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the CSV: %v", err)
+		}
+		cell := egressMatrixCSVCell{
+			sourceSgt:        field(record, "Source SGT"),
+			destinationSgt:   field(record, "Destination SGT"),
+			sgacls:           []string{},
+			defaultRule:      "NONE",
+			matrixCellStatus: "ENABLED",
+		}
+		if cell.sourceSgt == "" || cell.destinationSgt == "" {
+			return nil, fmt.Errorf("line %d: Source SGT and Destination SGT are required", line)
+		}
+		key := cell.sourceSgt + "/" + cell.destinationSgt
+		if keys[key] {
+			return nil, fmt.Errorf("line %d: cell %s is listed more than once", line, key)
+		}
+		keys[key] = true
+		if sgacls := field(record, "SGACL Name"); sgacls != "" {
+			for _, name := range strings.Split(sgacls, ";") {
+				if name = strings.TrimSpace(name); name != "" {
+					cell.sgacls = append(cell.sgacls, name)
+				}
+			}
+		}
+		if n := len(cell.sgacls); n > 0 {
+			if rule := egressMatrixCSVDefaultRule(cell.sgacls[n-1]); rule != "" {
+				cell.defaultRule = rule
+				cell.sgacls = cell.sgacls[:n-1]
+			}
+		}
+		if status := field(record, "Rule Status"); status != "" {
+			cell.matrixCellStatus = ""
+			for value, name := range egressMatrixCSVStatuses {
+				if strings.EqualFold(status, name) {
+					cell.matrixCellStatus = value
+				}
+			}
+			if cell.matrixCellStatus == "" {
+				return nil, fmt.Errorf("line %d: invalid Rule Status %q, expected Enabled, Disabled or Monitor", line, status)
+			}
+		}
+		cells = append(cells, cell)
+	}
+	return cells, nil
+}
+
+// renderEgressMatrixCSV returns the egress policy CSV of cells, in order.
+func renderEgressMatrixCSV(cells []egressMatrixCSVCell) (string, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(egressMatrixCSVHeader); err != nil {
+		return "", err
+	}
+	for _, cell := range cells {
+		record, err := cell.record()
+		if err != nil {
+			return "", err
+		}
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// csvCell returns the cell of a CSV row with its names resolved to ids.
+func (r *egressMatrixResolver) csvCell(cell egressMatrixCSVCell) (egressMatrixCell, error) {
+	sourceSgtID, err := r.sgtID(cell.sourceSgt)
+	if err != nil {
+		return egressMatrixCell{}, err
+	}
+	destinationSgtID, err := r.sgtID(cell.destinationSgt)
+	if err != nil {
+		return egressMatrixCell{}, err
+	}
+	sgacls, err := r.sgaclIDs(cell.sgacls)
+	if err != nil {
+		return egressMatrixCell{}, err
+	}
+	return egressMatrixCell{
+		sourceSgtID:      sourceSgtID,
+		destinationSgtID: destinationSgtID,
+		matrixCellStatus: cell.matrixCellStatus,
+		defaultRule:      cell.defaultRule,
+		sgacls:           sgacls,
+	}, nil
+}
+
+// csvCells returns the cells of an egress policy CSV with their names
+// resolved to ids.
+func (r *egressMatrixResolver) csvCells(content string) ([]egressMatrixCell, []egressMatrixCSVCell, error) {
+	rows, err := parseEgressMatrixCSV(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	cells := []egressMatrixCell{}
+	for _, row := range rows {
+		cell, err := r.csvCell(row)
+		if err != nil {
+			return nil, nil, err
+		}
+		cells = append(cells, cell)
+	}
+	return cells, rows, nil
+}
+
+// egressMatrixNames maps the ids of the security groups and security group
+// ACLs to their names.
+type egressMatrixNames struct {
+	sgts   map[string]string
+	sgacls map[string]string
+}
+
+// readEgressMatrixNames returns the names of all the security groups and
+// security group ACLs.
+func readEgressMatrixNames(m interface{}) (*egressMatrixNames, error) {
+	client := m.(ClientConfig).Client
+	names := &egressMatrixNames{sgts: map[string]string{}, sgacls: map[string]string{}}
+	sgtParams := isegosdk.GetSecurityGroupsQueryParams{Size: 100}
+	sgtResponse, _, err := client.SecurityGroups.GetSecurityGroups(&sgtParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the security groups: %v", err)
+	}
+	for _, item := range getAllItemsSecurityGroupsGetSecurityGroups(m, sgtResponse, &sgtParams) {
+		names.sgts[item.ID] = item.Name
+	}
+	sgaclParams := isegosdk.GetSecurityGroupsACLQueryParams{Size: 100}
+	sgaclResponse, _, err := client.SecurityGroupsACLs.GetSecurityGroupsACL(&sgaclParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the security group ACLs: %v", err)
+	}
+	for _, item := range getAllItemsSecurityGroupsACLsGetSecurityGroupsACL(m, sgaclResponse, &sgaclParams) {
+		names.sgacls[item.ID] = item.Name
+	}
+	return names, nil
+}
+
+// csvCell returns the CSV row of a cell, with its ids replaced by names.
+func (n *egressMatrixNames) csvCell(cell egressMatrixCell) (egressMatrixCSVCell, error) {
+	row := egressMatrixCSVCell{
+		sgacls:           []string{},
+		defaultRule:      cell.defaultRule,
+		matrixCellStatus: cell.matrixCellStatus,
+	}
+	var ok bool
+	if row.sourceSgt, ok = n.sgts[cell.sourceSgtID]; !ok {
+		return row, fmt.Errorf("security group %s of cell %s not found", cell.sourceSgtID, cell.name)
+	}
+	if row.destinationSgt, ok = n.sgts[cell.destinationSgtID]; !ok {
+		return row, fmt.Errorf("security group %s of cell %s not found", cell.destinationSgtID, cell.name)
+	}
+	for _, id := range cell.sgacls {
+		name, ok := n.sgacls[id]
+		if !ok {
+			return row, fmt.Errorf("security group ACL %s of cell %s not found", id, cell.name)
+		}
+		row.sgacls = append(row.sgacls, name)
+	}
+	return row, nil
+}
+
+// flattenEgressMatrixCSV returns the CSV of the cells listed in prior, an
+// egress policy CSV, as read from ISE. prior itself is returned when all its
+// cells match the cells on ISE, so it is kept as written. The CSV has no
+// description, so descriptions are left out of the comparison.
+func flattenEgressMatrixCSV(m interface{}, resolver *egressMatrixResolver, current []egressMatrixCell, prior string) (string, error) {
+	cells, rows, err := resolver.csvCells(prior)
+	if err != nil {
+		return "", err
+	}
+	byKey := map[string]egressMatrixCell{}
+	for _, cell := range current {
+		byKey[cell.key()] = cell
+	}
+	matches := true
+	for _, cell := range cells {
+		existing, ok := byKey[cell.key()]
+		cell.description = existing.description
+		if !ok || !existing.matches(cell) {
+			matches = false
+			break
+		}
+	}
+	if matches {
+		return prior, nil
+	}
+	var names *egressMatrixNames
+	flattened := []egressMatrixCSVCell{}
+	for i, cell := range cells {
+		existing, ok := byKey[cell.key()]
+		if !ok {
+			continue
+		}
+		row := egressMatrixCSVCell{
+			sourceSgt:        rows[i].sourceSgt,
+			destinationSgt:   rows[i].destinationSgt,
+			sgacls:           rows[i].sgacls,
+			defaultRule:      existing.defaultRule,
+			matrixCellStatus: existing.matrixCellStatus,
+		}
+		if !reflect.DeepEqual(nonNilStrings(existing.sgacls), nonNilStrings(cell.sgacls)) {
+			if names == nil {
+				if names, err = readEgressMatrixNames(m); err != nil {
+					return "", err
+				}
+			}
+			named, err := names.csvCell(existing)
+			if err != nil {
+				return "", err
+			}
+			row.sgacls = named.sgacls
+		}
+		flattened = append(flattened, row)
+	}
+	return renderEgressMatrixCSV(flattened)
+}
@@ -0,0 +1,101 @@
+package ciscoise
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseEgressMatrixCSV(t *testing.T) {
+	cases := map[string]struct {
+		Content  string
+		Expected []egressMatrixCSVCell
+		Error    string
+	}{
+		"GUI export": {
+			Content: "Source SGT,Destination SGT,SGACL Name,Rule Status\n" +
+				"Employees,Servers,Allow_Web;Allow_SSH,Enabled\n" +
+				"Guests,Servers,Deny IP,Monitor\n" +
+				"ANY,ANY,Permit IP,Enabled\n",
+			Expected: []egressMatrixCSVCell{
+				{sourceSgt: "Employees", destinationSgt: "Servers", sgacls: []string{"Allow_Web", "Allow_SSH"}, defaultRule: "NONE", matrixCellStatus: "ENABLED"},
+				{sourceSgt: "Guests", destinationSgt: "Servers", sgacls: []string{}, defaultRule: "DENY_IP", matrixCellStatus: "MONITOR"},
+				{sourceSgt: "ANY", destinationSgt: "ANY", sgacls: []string{}, defaultRule: "PERMIT_IP", matrixCellStatus: "ENABLED"},
+			},
+		},
+		"extra columns and defaults": {
+			Content: "\ufeffDestination SGT, Source SGT ,Description\n" +
+				"Servers,Employees,\"web, ssh\"\n",
+			Expected: []egressMatrixCSVCell{
+				{sourceSgt: "Employees", destinationSgt: "Servers", sgacls: []string{}, defaultRule: "NONE", matrixCellStatus: "ENABLED"},
+			},
+		},
+		"ACLs followed by the default rule": {
+			Content: "Source SGT,Destination SGT,SGACL Name,Rule Status\n" +
+				"Employees,Servers,Allow_Web; deny ip,disabled\n",
+			Expected: []egressMatrixCSVCell{
+				{sourceSgt: "Employees", destinationSgt: "Servers", sgacls: []string{"Allow_Web"}, defaultRule: "DENY_IP", matrixCellStatus: "DISABLED"},
+			},
+		},
+		"missing column": {
+			Content: "Source SGT,SGACL Name\nEmployees,Allow_Web\n",
+			Error:   "no Destination SGT column",
+		},
+		"duplicate cell": {
+			Content: "Source SGT,Destination SGT\nEmployees,Servers\nEmployees,Servers\n",
+			Error:   "line 3: cell Employees/Servers is listed more than once",
+		},
+		"invalid status": {
+			Content: "Source SGT,Destination SGT,Rule Status\nEmployees,Servers,On\n",
+			Error:   "invalid Rule Status",
+		},
+	}
+	for tn, tc := range cases {
+		actual, err := parseEgressMatrixCSV(tc.Content)
+		if tc.Error != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.Error) {
+				t.Errorf("bad: %s, expected error %q, got %v", tn, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("bad: %s, unexpected error %v", tn, err)
+			continue
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("bad: %s, expected %+v, got %+v", tn, tc.Expected, actual)
+		}
+	}
+}
+
+func TestRenderEgressMatrixCSVRoundTrip(t *testing.T) {
+	content := "Source SGT,Destination SGT,SGACL Name,Rule Status\n" +
+		"Employees,Servers,Allow_Web;Allow_SSH,Enabled\n" +
+		"Guests,Servers,Deny IP,Monitor\n" +
+		"\"Lab, West\",Servers,Allow_Web;Permit IP,Disabled\n" +
+		"Contractors,Servers,,Enabled\n" +
+		"Printers,Servers,Allow_Web;Permit IP;Permit IP,Enabled\n"
+	cells, err := parseEgressMatrixCSV(content)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	actual, err := renderEgressMatrixCSV(cells)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if actual != content {
+		t.Errorf("bad: expected\n%s\ngot\n%s", content, actual)
+	}
+	if sgacls := cells[4].sgacls; cells[4].defaultRule != "PERMIT_IP" || !reflect.DeepEqual(sgacls, []string{"Allow_Web", "Permit IP"}) {
+		t.Errorf("bad: expected the Permit IP SGACL and default rule, got %v and %s", sgacls, cells[4].defaultRule)
+	}
+
+	// The Permit IP SGACL without default rule would read back as the
+	// default rule.
+	_, err = renderEgressMatrixCSV([]egressMatrixCSVCell{
+		{sourceSgt: "Printers", destinationSgt: "Servers", sgacls: []string{"Allow_Web", "Permit IP"}, defaultRule: "NONE", matrixCellStatus: "ENABLED"},
+	})
+	if err == nil {
+		t.Errorf("bad: expected an error on the last SGACL named like a default rule")
+	}
+}
@@ -0,0 +1,247 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+// egressMatrixDefaultCellName is the name of the ANY-ANY cell holding the
+// default egress policy, which can be updated but not deleted.
+const egressMatrixDefaultCellName = "ANY-ANY"
+
+// egressMatrixCellMediaType is the resource media type of the egress matrix
+// cell bulk requests.
+const egressMatrixCellMediaType = "vnd.com.cisco.ise.trustsec.egressmatrixcell.1.0+xml"
+
+// egressMatrixCell is a cell of the TrustSec egress matrix, identified by
+// its source and destination security groups.
+type egressMatrixCell struct {
+	id               string
+	name             string
+	description      string
+	sourceSgtID      string
+	destinationSgtID string
+	matrixCellStatus string
+	defaultRule      string
+	sgacls           []string
+}
+
+func (c egressMatrixCell) key() string {
+	return c.sourceSgtID + "/" + c.destinationSgtID
+}
+
+func (c egressMatrixCell) isDefault() bool {
+	return c.name == egressMatrixDefaultCellName
+}
+
+// matches reports whether the cell already has the policy of desired.
+func (c egressMatrixCell) matches(desired egressMatrixCell) bool {
+	return c.description == desired.description &&
+		c.matrixCellStatus == desired.matrixCellStatus &&
+		c.defaultRule == desired.defaultRule &&
+		reflect.DeepEqual(nonNilStrings(c.sgacls), nonNilStrings(desired.sgacls))
+}
+
+// egressMatrixChanges are the cells to create, update and delete to move the
+// matrix on ISE to the desired one.
+type egressMatrixChanges struct {
+	create []egressMatrixCell
+	update []egressMatrixCell
+	delete []egressMatrixCell
+}
+
+func (c egressMatrixChanges) empty() bool {
+	return len(c.create) == 0 && len(c.update) == 0 && len(c.delete) == 0
+}
+
+// diffEgressMatrix returns the changes from the current cells to the desired
+// ones. Cells missing from desired are deleted when exclusive, or when they
+// are in managed, the keys of the cells previously applied. The default cell
+// is never deleted.
+func diffEgressMatrix(current []egressMatrixCell, desired []egressMatrixCell, managed map[string]bool, exclusive bool) egressMatrixChanges {
+	changes := egressMatrixChanges{}
+	byKey := map[string]egressMatrixCell{}
+	for _, cell := range current {
+		byKey[cell.key()] = cell
+	}
+	listed := map[string]bool{}
+	for _, cell := range desired {
+		listed[cell.key()] = true
+		existing, ok := byKey[cell.key()]
+		switch {
+		case !ok:
+			changes.create = append(changes.create, cell)
+		case !existing.matches(cell):
+			cell.id = existing.id
+			cell.name = existing.name
+			changes.update = append(changes.update, cell)
+		}
+	}
+	for _, cell := range current {
+		if listed[cell.key()] || cell.isDefault() {
+			continue
+		}
+		if exclusive || managed[cell.key()] {
+			changes.delete = append(changes.delete, cell)
+		}
+	}
+	return changes
+}
+
+// readEgressMatrixCells returns the cells of the egress matrix by name. The
+// list only has the cell names, so each cell is then read by id, which costs
+// one request per cell. When ids is not nil only the cells with these ids
+// are read, cells no longer listed on ISE are skipped.
+func readEgressMatrixCells(m interface{}, ids map[string]bool) ([]egressMatrixCell, *resty.Response, error) {
+	client := m.(ClientConfig).Client
+	queryParams := isegosdk.GetEgressMatrixCellQueryParams{Size: 100}
+	response, restyResp, err := client.EgressMatrixCell.GetEgressMatrixCell(&queryParams)
+	if err != nil {
+		return nil, restyResp, err
+	}
+	cells := []egressMatrixCell{}
+	for _, item := range getAllItemsEgressMatrixCellGetEgressMatrixCell(m, response, &queryParams) {
+		if ids != nil && !ids[item.ID] {
+			continue
+		}
+		getItem, restyResp, err := client.EgressMatrixCell.GetEgressMatrixCellByID(item.ID)
+		if err != nil {
+			return nil, restyResp, err
+		}
+		if getItem == nil || getItem.EgressMatrixCell == nil {
+			return nil, restyResp, fmt.Errorf("empty response from GetEgressMatrixCellByID %s", item.ID)
+		}
+		cells = append(cells, newEgressMatrixCell(getItem.EgressMatrixCell))
+	}
+	sort.SliceStable(cells, func(i, j int) bool {
+		return cells[i].name < cells[j].name
+	})
+	return cells, restyResp, nil
+}
+
+func newEgressMatrixCell(item *isegosdk.ResponseEgressMatrixCellGetEgressMatrixCellByIDEgressMatrixCell) egressMatrixCell {
+	return egressMatrixCell{
+		id:               item.ID,
+		name:             item.Name,
+		description:      item.Description,
+		sourceSgtID:      item.SourceSgtID,
+		destinationSgtID: item.DestinationSgtID,
+		matrixCellStatus: item.MatrixCellStatus,
+		defaultRule:      item.DefaultRule,
+		sgacls:           item.Sgacls,
+	}
+}
+
+// applyEgressMatrixChanges pushes the changes with one bulk request per
+// operation: deletes, then updates, then creates.
+func applyEgressMatrixChanges(ctx context.Context, clientConfig ClientConfig, timeout time.Duration, changes egressMatrixChanges) diag.Diagnostics {
+	var diags diag.Diagnostics
+	monitor := func(bulkID string) (interface{}, *resty.Response, error) {
+		return clientConfig.Client.EgressMatrixCell.MonitorBulkStatusEgressMatrixCell(bulkID)
+	}
+	requests := []*ersBulkRequest{}
+	if len(changes.delete) > 0 {
+		request := &ersBulkRequest{OperationType: "delete", ResourceMediaType: egressMatrixCellMediaType}
+		for _, cell := range changes.delete {
+			request.IDList = append(request.IDList, cell.id)
+		}
+		requests = append(requests, request)
+	}
+	if len(changes.update) > 0 {
+		request := &ersBulkRequest{OperationType: "update", ResourceMediaType: egressMatrixCellMediaType}
+		for _, cell := range changes.update {
+			request.ResourcesList = append(request.ResourcesList, &isegosdk.RequestEgressMatrixCellUpdateEgressMatrixCellByID{
+				EgressMatrixCell: &isegosdk.RequestEgressMatrixCellUpdateEgressMatrixCellByIDEgressMatrixCell{
+					ID:               cell.id,
+					Name:             cell.name,
+					Description:      cell.description,
+					SourceSgtID:      cell.sourceSgtID,
+					DestinationSgtID: cell.destinationSgtID,
+					MatrixCellStatus: cell.matrixCellStatus,
+					DefaultRule:      cell.defaultRule,
+					Sgacls:           cell.sgacls,
+				},
+			})
+		}
+		requests = append(requests, request)
+	}
+	if len(changes.create) > 0 {
+		request := &ersBulkRequest{OperationType: "create", ResourceMediaType: egressMatrixCellMediaType}
+		for _, cell := range changes.create {
+			request.ResourcesList = append(request.ResourcesList, &isegosdk.RequestEgressMatrixCellCreateEgressMatrixCell{
+				EgressMatrixCell: &isegosdk.RequestEgressMatrixCellCreateEgressMatrixCellEgressMatrixCell{
+					Description:      cell.description,
+					SourceSgtID:      cell.sourceSgtID,
+					DestinationSgtID: cell.destinationSgtID,
+					MatrixCellStatus: cell.matrixCellStatus,
+					DefaultRule:      cell.defaultRule,
+					Sgacls:           cell.sgacls,
+				},
+			})
+		}
+		requests = append(requests, request)
+	}
+	for _, request := range requests {
+		_, _, bulkDiags := runBulkRequest(ctx, clientConfig, timeout, "BulkRequestForEgressMatrixCell", "/ers/config/egressmatrixcell/bulk/submit", "EgressMatrixCellBulkRequest", request, monitor)
+		diags = append(diags, bulkDiags...)
+		if bulkDiags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
+
+// egressMatrixResolver resolves security group and security group ACL names
+// to ids, looking each name up once.
+type egressMatrixResolver struct {
+	client *isegosdk.Client
+	sgts   map[string]string
+	sgacls map[string]string
+}
+
+func newEgressMatrixResolver(client *isegosdk.Client) *egressMatrixResolver {
+	return &egressMatrixResolver{client: client, sgts: map[string]string{}, sgacls: map[string]string{}}
+}
+
+func (r *egressMatrixResolver) sgtID(name string) (string, error) {
+	if id, ok := r.sgts[name]; ok {
+		return id, nil
+	}
+	id, err := resolveSecurityGroupID(r.client, name)
+	if err != nil {
+		return "", err
+	}
+	r.sgts[name] = id
+	return id, nil
+}
+
+func (r *egressMatrixResolver) sgaclIDs(names []string) ([]string, error) {
+	ids := []string{}
+	for _, name := range names {
+		id, ok := r.sgacls[name]
+		if !ok {
+			var err error
+			if id, err = resolveSecurityGroupsACLID(r.client, name); err != nil {
+				return nil, err
+			}
+			r.sgacls[name] = id
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,165 @@
+package ciscoise
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDiffEgressMatrix(t *testing.T) {
+	current := []egressMatrixCell{
+		{id: "c0", name: "ANY-ANY", sourceSgtID: "any", destinationSgtID: "any", matrixCellStatus: "ENABLED", defaultRule: "PERMIT_IP"},
+		{id: "c1", name: "Employees-Servers", sourceSgtID: "e", destinationSgtID: "s", matrixCellStatus: "ENABLED", defaultRule: "NONE", sgacls: []string{"a1"}},
+		{id: "c2", name: "Guests-Servers", sourceSgtID: "g", destinationSgtID: "s", matrixCellStatus: "ENABLED", defaultRule: "DENY_IP"},
+		{id: "c3", name: "Contractors-Servers", sourceSgtID: "c", destinationSgtID: "s", matrixCellStatus: "ENABLED", defaultRule: "DENY_IP"},
+	}
+	desired := []egressMatrixCell{
+		{sourceSgtID: "e", destinationSgtID: "s", matrixCellStatus: "ENABLED", defaultRule: "NONE", sgacls: []string{"a1"}},
+		{sourceSgtID: "g", destinationSgtID: "s", matrixCellStatus: "ENABLED", defaultRule: "NONE", sgacls: []string{"a2"}},
+		{sourceSgtID: "p", destinationSgtID: "s", matrixCellStatus: "MONITOR", defaultRule: "DENY_IP"},
+	}
+	cases := map[string]struct {
+		Managed   map[string]bool
+		Exclusive bool
+		Expected  []string
+	}{
+		"unmanaged cells are kept": {
+			Managed:  map[string]bool{},
+			Expected: []string{"create p/s", "update c2"},
+		},
+		"managed cells are deleted": {
+			Managed:  map[string]bool{"c/s": true},
+			Expected: []string{"create p/s", "update c2", "delete c3"},
+		},
+		"exclusive": {
+			Managed:   map[string]bool{},
+			Exclusive: true,
+			Expected:  []string{"create p/s", "update c2", "delete c3"},
+		},
+	}
+	for tn, tc := range cases {
+		changes := diffEgressMatrix(current, desired, tc.Managed, tc.Exclusive)
+		actual := []string{}
+		for _, cell := range changes.create {
+			actual = append(actual, "create "+cell.key())
+		}
+		for _, cell := range changes.update {
+			actual = append(actual, "update "+cell.id)
+		}
+		for _, cell := range changes.delete {
+			actual = append(actual, "delete "+cell.id)
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("bad: %s, expected changes %v, got %v", tn, tc.Expected, actual)
+		}
+	}
+	if changes := diffEgressMatrix(current[:2], current[1:2], map[string]bool{}, true); !changes.empty() {
+		t.Errorf("expected no changes for a matching matrix, got %+v", changes)
+	}
+}
+
+func TestFlattenEgressMatrixCell(t *testing.T) {
+	resolver := &egressMatrixResolver{sgts: map[string]string{"Employees": "e"}, sgacls: map[string]string{"Permit_Web": "a1"}}
+	cell := egressMatrixCell{sourceSgtID: "e", destinationSgtID: "s", matrixCellStatus: "ENABLED", defaultRule: "NONE", sgacls: []string{"a1"}}
+	prior := map[string]interface{}{
+		"source_sgt_name":    "Employees",
+		"destination_sgt_id": "s",
+		"sgacl_names":        []interface{}{"Permit_Web"},
+		"matrix_cell_status": "ENABLED",
+		"default_rule":       "NONE",
+	}
+	expanded, err := expandEgressMatrixCell(resolver, prior)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(expanded, cell) {
+		t.Errorf("expected cell %+v, got %+v", cell, expanded)
+	}
+	flattened := flattenEgressMatrixCell(resolver, cell, prior)
+	if flattened["source_sgt_name"] != "Employees" || flattened["source_sgt_id"] != "" || flattened["destination_sgt_id"] != "s" {
+		t.Errorf("expected the security groups in the form of the prior cell, got %v", flattened)
+	}
+	if !reflect.DeepEqual(flattened["sgacl_names"], []string{"Permit_Web"}) || len(flattened["sgacls"].([]string)) != 0 {
+		t.Errorf("expected the security group ACLs by name, got %v", flattened)
+	}
+	cell.sgacls = []string{"a2"}
+	if flattened := flattenEgressMatrixCell(resolver, cell, prior); !reflect.DeepEqual(flattened["sgacls"], []string{"a2"}) || flattened["sgacl_names"] != nil {
+		t.Errorf("expected the security group ACL ids when the names no longer match, got %v", flattened)
+	}
+	if _, err := expandEgressMatrixCell(resolver, map[string]interface{}{"source_sgt_id": "e", "source_sgt_name": "Employees", "destination_sgt_id": "s"}); err == nil {
+		t.Errorf("expected an error when both source_sgt_id and source_sgt_name are set")
+	}
+}
+
+func TestEgressMatrixCurrentCells(t *testing.T) {
+	cells := map[string]map[string]interface{}{
+		"c0": {"id": "c0", "name": "ANY-ANY", "sourceSgtId": "any", "destinationSgtId": "any"},
+		"c1": {"id": "c1", "name": "Employees-Servers", "sourceSgtId": "s1", "destinationSgtId": "s2"},
+		"c2": {"id": "c2", "name": "Guests-Servers", "sourceSgtId": "s3", "destinationSgtId": "s2"},
+	}
+	reads := []string{}
+	m := newTestClientConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if id := strings.TrimPrefix(r.URL.Path, "/ers/config/egressmatrixcell/"); id != r.URL.Path {
+			reads = append(reads, id)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"EgressMatrixCell": cells[id]})
+			return
+		}
+		resources := []interface{}{}
+		for _, id := range []string{"c0", "c1", "c2"} {
+			resources = append(resources, map[string]interface{}{"id": id, "name": cells[id]["name"]})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"SearchResult": map[string]interface{}{"total": len(resources), "resources": resources},
+		})
+	})
+
+	cases := map[string]struct {
+		Exclusive     bool
+		Keys          []string
+		ExpectedReads []string
+	}{
+		"managed cells": {
+			Keys:          []string{"s1/s2"},
+			ExpectedReads: []string{"c1"},
+		},
+		"new cell": {
+			Keys:          []string{"s1/s2", "s3/s2"},
+			ExpectedReads: []string{"c0", "c1", "c2"},
+		},
+		"exclusive": {
+			Exclusive:     true,
+			Keys:          []string{"s1/s2"},
+			ExpectedReads: []string{"c0", "c1", "c2"},
+		},
+	}
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceEgressMatrix().Schema, map[string]interface{}{})
+		// c4 was applied before and then deleted on ISE, so it is not read.
+		_ = d.Set("item", []map[string]interface{}{{"cells": []map[string]interface{}{
+			{"id": "c1", "name": "Employees-Servers", "source_sgt_id": "s1", "destination_sgt_id": "s2"},
+			{"id": "c4", "name": "Contractors-Servers", "source_sgt_id": "s4", "destination_sgt_id": "s2"},
+		}}})
+		keys := map[string]bool{}
+		for _, key := range tc.Keys {
+			keys[key] = true
+		}
+		reads = []string{}
+		current, _, err := resourceEgressMatrixCurrentCells(d, m, tc.Exclusive, keys)
+		if err != nil {
+			t.Fatalf("bad: %s, unexpected error %v", tn, err)
+		}
+		sort.Strings(reads)
+		if !reflect.DeepEqual(reads, tc.ExpectedReads) {
+			t.Errorf("bad: %s, expected reads %v, got %v", tn, tc.ExpectedReads, reads)
+		}
+		if len(current) != len(tc.ExpectedReads) {
+			t.Errorf("bad: %s, expected %d cells, got %v", tn, len(tc.ExpectedReads), current)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package ciscoise
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// failoverTransport sends the requests addressed to the primary PAN to the
+// first node of the list that answers. A node is skipped when the connection
+// fails or it answers with a 5xx status. Non idempotent requests are only sent
+// to the next node when the connection could not be opened, since otherwise
+// the request may have been processed. The node that served a request stays
+// active for the next ones, so a PAN down for patching only costs one attempt.
+type failoverTransport struct {
+	nodes []*url.URL
+	next  http.RoundTripper
+
+	mutex  sync.Mutex
+	active int
+}
+
+func newFailoverTransport(baseURLs []string, next http.RoundTripper) (http.RoundTripper, error) {
+	if len(baseURLs) < 2 {
+		return next, nil
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	nodes := []*url.URL{}
+	for _, baseURL := range baseURLs {
+		node, err := url.Parse(strings.TrimSpace(baseURL))
+		if err != nil || node.Scheme == "" || node.Host == "" {
+			return nil, fmt.Errorf("invalid PAN base URL %q, expected a value such as https://ise.example.com", baseURL)
+		}
+		nodes = append(nodes, node)
+	}
+	return &failoverTransport{nodes: nodes, next: next}, nil
+}
+
+func (t *failoverTransport) activeNode() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.active
+}
+
+func (t *failoverTransport) setActiveNode(active int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.active = active
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	primary := t.nodes[0]
+	if !strings.EqualFold(req.URL.Hostname(), primary.Hostname()) {
+		return t.next.RoundTrip(req)
+	}
+
+	var resp *http.Response
+	var err error
+	active := t.activeNode()
+	for i := 0; i < len(t.nodes); i++ {
+		index := (active + i) % len(t.nodes)
+		node := t.nodes[index]
+		nodeReq, nodeErr := failoverRequest(req, primary, node, i > 0)
+		if nodeErr != nil {
+			// The body can not be sent again, return the last answer.
+			break
+		}
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		resp, err = t.next.RoundTrip(nodeReq)
+		if !isFailoverResponse(req.Method, resp, err) {
+			if index != active {
+				log.Printf("[WARN] PAN %s did not answer, failing over to %s", t.nodes[active].Host, node.Host)
+				t.setActiveNode(index)
+			}
+			log.Printf("[DEBUG] Request %s %s served by %s", req.Method, req.URL.Path, nodeReq.URL.Host)
+			return resp, err
+		}
+		if err != nil {
+			log.Printf("[WARN] Request %s %s to %s failed: %v", req.Method, req.URL.Path, nodeReq.URL.Host, err)
+		} else {
+			log.Printf("[WARN] Request %s %s to %s failed with status %s", req.Method, req.URL.Path, nodeReq.URL.Host, resp.Status)
+		}
+		if req.Context().Err() != nil {
+			break
+		}
+	}
+	return resp, err
+}
+
+// isFailoverResponse reports whether the request should be sent to the next PAN.
+// As for retries, only idempotent requests are sent again once they may have
+// reached the PAN. Other requests only fail over when the connection could not
+// be opened.
+func isFailoverResponse(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotentMethod(method) || isDialError(err)
+	}
+	return resp != nil && resp.StatusCode >= http.StatusInternalServerError && isIdempotentMethod(method)
+}
+
+// isDialError reports whether err happened while opening the connection, so
+// the request was not sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// failoverRequest returns req addressed to node instead of the primary PAN.
+// The port added by the SDK for the API group (9060 for ERS, 443 for
+// OpenAPI) is kept, unless the request uses the port of the primary base URL.
+func failoverRequest(req *http.Request, primary *url.URL, node *url.URL, resend bool) (*http.Request, error) {
+	nodeReq := req.Clone(req.Context())
+	if resend && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, fmt.Errorf("request body of %s %s can not be sent again", req.Method, req.URL.Path)
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		nodeReq.Body = body
+	}
+	port := req.URL.Port()
+	if port == primary.Port() {
+		port = node.Port()
+	}
+	nodeReq.URL.Scheme = node.Scheme
+	nodeReq.URL.Host = node.Host
+	if port != "" {
+		nodeReq.URL.Host = net.JoinHostPort(node.Hostname(), port)
+	} else if node.Port() != "" {
+		nodeReq.URL.Host = strings.TrimSuffix(node.Host, ":"+node.Port())
+	}
+	nodeReq.Host = ""
+	return nodeReq, nil
+}
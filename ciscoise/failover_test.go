@@ -0,0 +1,142 @@
+package ciscoise
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type hostsTransport struct {
+	down     map[string]bool
+	reset    map[string]bool
+	statuses map[string]int
+	hosts    []string
+	bodies   []string
+}
+
+func (t *hostsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.hosts = append(t.hosts, req.URL.Host)
+	if req.Body != nil {
+		body, _ := ioutil.ReadAll(req.Body)
+		t.bodies = append(t.bodies, string(body))
+	}
+	if t.down[req.URL.Hostname()] {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	}
+	if t.reset[req.URL.Hostname()] {
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+	}
+	statusCode := http.StatusOK
+	if code, ok := t.statuses[req.URL.Hostname()]; ok {
+		statusCode = code
+	}
+	return &http.Response{StatusCode: statusCode, Status: http.StatusText(statusCode), Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestFailoverTransport(t *testing.T) {
+	tests := []struct {
+		method   string
+		down     map[string]bool
+		reset    map[string]bool
+		statuses map[string]int
+		url      string
+		hosts    []string
+		status   int
+	}{
+		{
+			http.MethodPost, nil, nil, nil,
+			"https://pan1.example.com:9060/ers/config/sgt",
+			[]string{"pan1.example.com:9060"},
+			http.StatusOK,
+		},
+		{
+			http.MethodPost, map[string]bool{"pan1.example.com": true}, nil, nil,
+			"https://pan1.example.com:9060/ers/config/sgt",
+			[]string{"pan1.example.com:9060", "pan2.example.com:9060"},
+			http.StatusOK,
+		},
+		{
+			http.MethodPost, nil, map[string]bool{"pan1.example.com": true}, nil,
+			"https://pan1.example.com:9060/ers/config/sgt",
+			[]string{"pan1.example.com:9060"},
+			0,
+		},
+		{
+			http.MethodPut, nil, map[string]bool{"pan1.example.com": true}, nil,
+			"https://pan1.example.com:9060/ers/config/sgt/1234",
+			[]string{"pan1.example.com:9060", "pan2.example.com:9060"},
+			http.StatusOK,
+		},
+		{
+			http.MethodGet, nil, nil, map[string]int{"pan1.example.com": http.StatusServiceUnavailable},
+			"https://pan1.example.com/api/v1/policy/network-access/policy-set",
+			[]string{"pan1.example.com", "pan2.example.com"},
+			http.StatusOK,
+		},
+		{
+			http.MethodPost, nil, nil, map[string]int{"pan1.example.com": http.StatusInternalServerError},
+			"https://pan1.example.com/api/v1/policy/network-access/policy-set",
+			[]string{"pan1.example.com"},
+			http.StatusInternalServerError,
+		},
+		{
+			http.MethodPost, nil, nil, map[string]int{"pan1.example.com": http.StatusNotFound},
+			"https://pan1.example.com:9060/ers/config/sgt",
+			[]string{"pan1.example.com:9060"},
+			http.StatusNotFound,
+		},
+		{
+			http.MethodPost, map[string]bool{"pan1.example.com": true}, nil, nil,
+			"https://psn.example.com:9060/ers/config/sgt",
+			[]string{"psn.example.com:9060"},
+			http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		next := &hostsTransport{down: test.down, reset: test.reset, statuses: test.statuses}
+		transport, err := newFailoverTransport([]string{"https://pan1.example.com", "https://pan2.example.com"}, next)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		req, _ := http.NewRequest(test.method, test.url, strings.NewReader(`{"Sgt":{}}`))
+		resp, _ := transport.RoundTrip(req)
+		if strings.Join(next.hosts, ",") != strings.Join(test.hosts, ",") {
+			t.Errorf("Mismatch on val %s %#v: expected hosts %#v but got %#v", test.method, test.url, test.hosts, next.hosts)
+		}
+		for _, body := range next.bodies {
+			if body != `{"Sgt":{}}` {
+				t.Errorf("Mismatch on val %#v: expected the body to be sent again but got %#v", test.url, body)
+			}
+		}
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		if statusCode != test.status {
+			t.Errorf("Mismatch on val %s %#v: expected status %#v but got %#v", test.method, test.url, test.status, statusCode)
+		}
+	}
+}
+
+func TestFailoverTransportKeepsActiveNode(t *testing.T) {
+	next := &hostsTransport{down: map[string]bool{"pan1.example.com": true}}
+	transport, _ := newFailoverTransport([]string{"https://pan1.example.com", "https://pan2.example.com"}, next)
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "https://pan1.example.com:9060/ers/config/sgt", nil)
+		transport.RoundTrip(req)
+	}
+	expected := "pan1.example.com:9060,pan2.example.com:9060,pan2.example.com:9060"
+	if strings.Join(next.hosts, ",") != expected {
+		t.Errorf("Mismatch on hosts: expected %#v but got %#v", expected, strings.Join(next.hosts, ","))
+	}
+}
+
+func TestFailoverTransportInvalidURL(t *testing.T) {
+	if _, err := newFailoverTransport([]string{"https://pan1.example.com", "pan2"}, nil); err == nil {
+		t.Errorf("Expected error on fallback base URL without scheme")
+	}
+}
@@ -0,0 +1,124 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// parseImportID returns the resource ID parameters of an import ID, which is
+// a bare UUID, a bare name, `name:<value>` or an ID already in the
+// `key:=value` form of joinResourceID.
+func parseImportID(importID string) (map[string]string, error) {
+	if strings.Contains(importID, ":=") {
+		return separateResourceID(importID), nil
+	}
+	importID = strings.TrimSpace(importID)
+	if strings.HasPrefix(importID, "name:") {
+		name := strings.TrimPrefix(importID, "name:")
+		if name == "" {
+			return nil, fmt.Errorf("invalid import ID %q, the name is empty", importID)
+		}
+		return map[string]string{"name": name}, nil
+	}
+	if importID == "" {
+		return nil, fmt.Errorf("invalid import ID, expected a UUID, a name or name:<value>")
+	}
+	if uuidRegexp.MatchString(importID) {
+		return map[string]string{"id": importID}, nil
+	}
+	return map[string]string{"name": importID}, nil
+}
+
+// parseRuleImportID returns the resource ID parameters of a policy rule import
+// ID, which is `<policy_id>/<rule>` where rule is accepted by parseImportID.
+func parseRuleImportID(importID string) (map[string]string, error) {
+	if strings.Contains(importID, ":=") {
+		return separateResourceID(importID), nil
+	}
+	parts := strings.SplitN(strings.TrimSpace(importID), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected policy_id/rule_id or policy_id/rule_name", importID)
+	}
+	resourceMap, err := parseImportID(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	resourceMap["policy_id"] = parts[0]
+	return resourceMap, nil
+}
+
+// importStateByIDOrName returns an importer accepting the IDs of
+// parseImportID. The resource is looked up by the read function, through its
+// GetByID or GetByName call, and the ID is set to the one Create would set.
+func importStateByIDOrName(read schema.ReadContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		resourceMap, err := parseImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		return importResource(ctx, d, m, read, resourceMap, "item.0")
+	}
+}
+
+// importStateGlobalRuleByIDOrName is importStateByIDOrName for the global
+// exception rules, which keep their id and name under item.0.rule.0.
+func importStateGlobalRuleByIDOrName(read schema.ReadContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		resourceMap, err := parseImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		return importResource(ctx, d, m, read, resourceMap, "item.0.rule.0")
+	}
+}
+
+// importStateRuleByIDOrName is importStateByIDOrName for the rules of a
+// policy set, imported as `<policy_id>/<rule>`.
+func importStateRuleByIDOrName(read schema.ReadContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		resourceMap, err := parseRuleImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		return importResource(ctx, d, m, read, resourceMap, "item.0.rule.0")
+	}
+}
+
+func importResource(ctx context.Context, d *schema.ResourceData, m interface{}, read schema.ReadContextFunc, resourceMap map[string]string, itemKey string) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	d.SetId(joinResourceID(resourceMap))
+	if err := diagsToError(read(ctx, d, m)); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("unable to import %q, no matching object found", importID)
+	}
+	for _, key := range []string{"id", "name"} {
+		if v, ok := d.GetOk(itemKey + "." + key); ok {
+			resourceMap[key] = interfaceToString(v)
+		}
+	}
+	d.SetId(joinResourceID(resourceMap))
+	return []*schema.ResourceData{d}, nil
+}
+
+// diagsToError returns the first error of diags, nil if there is none.
+func diagsToError(diags diag.Diagnostics) error {
+	for _, diagnostic := range diags {
+		if diagnostic.Severity != diag.Error {
+			continue
+		}
+		if diagnostic.Detail == "" {
+			return fmt.Errorf("%s", diagnostic.Summary)
+		}
+		return fmt.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+	return nil
+}
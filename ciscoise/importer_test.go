@@ -0,0 +1,217 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestImporterParseImportID(t *testing.T) {
+	cases := map[string]struct {
+		ImportID    string
+		Expected    map[string]string
+		ExpectError bool
+	}{
+		"uuid": {
+			ImportID: "93ad6890-8c01-11e6-996c-525400b48521",
+			Expected: map[string]string{"id": "93ad6890-8c01-11e6-996c-525400b48521"},
+		},
+		"bare name": {
+			ImportID: "Employees",
+			Expected: map[string]string{"name": "Employees"},
+		},
+		"prefixed name": {
+			ImportID: "name:93ad6890-8c01-11e6-996c-525400b48521",
+			Expected: map[string]string{"name": "93ad6890-8c01-11e6-996c-525400b48521"},
+		},
+		"name with spaces": {
+			ImportID: "name:Wired Employees",
+			Expected: map[string]string{"name": "Wired Employees"},
+		},
+		"resource ID": {
+			ImportID: "id:=93ad6890-8c01-11e6-996c-525400b48521\\name:=Employees",
+			Expected: map[string]string{"id": "93ad6890-8c01-11e6-996c-525400b48521", "name": "Employees"},
+		},
+		"empty": {
+			ImportID:    "",
+			ExpectError: true,
+		},
+		"empty name": {
+			ImportID:    "name:",
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		result, err := parseImportID(tc.ImportID)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expected error %t, got %v", tn, tc.ExpectError, err)
+			continue
+		}
+		if !tc.ExpectError && !reflect.DeepEqual(result, tc.Expected) {
+			t.Errorf("bad: %s, expected %v, got %v", tn, tc.Expected, result)
+		}
+	}
+}
+
+func TestImporterParseRuleImportID(t *testing.T) {
+	cases := map[string]struct {
+		ImportID    string
+		Expected    map[string]string
+		ExpectError bool
+	}{
+		"rule uuid": {
+			ImportID: "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10/93ad6890-8c01-11e6-996c-525400b48521",
+			Expected: map[string]string{"policy_id": "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10", "id": "93ad6890-8c01-11e6-996c-525400b48521"},
+		},
+		"rule name": {
+			ImportID: "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10/Basic_Authenticated_Access",
+			Expected: map[string]string{"policy_id": "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10", "name": "Basic_Authenticated_Access"},
+		},
+		"rule name with slash": {
+			ImportID: "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10/name:Wired/Wireless",
+			Expected: map[string]string{"policy_id": "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10", "name": "Wired/Wireless"},
+		},
+		"resource ID": {
+			ImportID: "id:=93ad6890-8c01-11e6-996c-525400b48521\\policy_id:=41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10",
+			Expected: map[string]string{"policy_id": "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10", "id": "93ad6890-8c01-11e6-996c-525400b48521"},
+		},
+		"missing policy": {
+			ImportID:    "93ad6890-8c01-11e6-996c-525400b48521",
+			ExpectError: true,
+		},
+		"missing rule": {
+			ImportID:    "41ac2e6e-3d65-4b2e-9a17-7c5d8e4e2d10/",
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		result, err := parseRuleImportID(tc.ImportID)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expected error %t, got %v", tn, tc.ExpectError, err)
+			continue
+		}
+		if !tc.ExpectError && !reflect.DeepEqual(result, tc.Expected) {
+			t.Errorf("bad: %s, expected %v, got %v", tn, tc.Expected, result)
+		}
+	}
+}
+
+func TestImporterImportStateByIDOrName(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"item": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":   &schema.Schema{Type: schema.TypeString, Computed: true},
+						"name": &schema.Schema{Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+	objects := []map[string]interface{}{
+		{"id": "93ad6890-8c01-11e6-996c-525400b48521", "name": "Employees"},
+	}
+	read := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		resourceMap := separateResourceID(d.Id())
+		if resourceMap["name"] == "broken" {
+			return diag.FromErr(fmt.Errorf("unexpected response"))
+		}
+		for _, object := range objects {
+			if object["id"] == resourceMap["id"] || object["name"] == resourceMap["name"] {
+				_ = d.Set("item", []map[string]interface{}{object})
+				return nil
+			}
+		}
+		d.SetId("")
+		return nil
+	}
+	cases := map[string]struct {
+		ImportID    string
+		Expected    string
+		ExpectError bool
+	}{
+		"by uuid": {
+			ImportID: "93ad6890-8c01-11e6-996c-525400b48521",
+			Expected: "id:=93ad6890-8c01-11e6-996c-525400b48521\\name:=Employees",
+		},
+		"by name": {
+			ImportID: "name:Employees",
+			Expected: "id:=93ad6890-8c01-11e6-996c-525400b48521\\name:=Employees",
+		},
+		"not found": {
+			ImportID:    "Contractors",
+			ExpectError: true,
+		},
+		"read error": {
+			ImportID:    "broken",
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		d := resource.TestResourceData()
+		d.SetId(tc.ImportID)
+		result, err := importStateByIDOrName(read)(context.Background(), d, nil)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expected error %t, got %v", tn, tc.ExpectError, err)
+			continue
+		}
+		if !tc.ExpectError && result[0].Id() != tc.Expected {
+			t.Errorf("bad: %s, expected ID %q, got %q", tn, tc.Expected, result[0].Id())
+		}
+	}
+}
+
+func TestImporterImportStateGlobalRuleByIDOrName(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"item": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id":   &schema.Schema{Type: schema.TypeString, Computed: true},
+									"name": &schema.Schema{Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	rule := map[string]interface{}{"id": "93ad6890-8c01-11e6-996c-525400b48521", "name": "Block_Quarantine"}
+	read := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		resourceMap := separateResourceID(d.Id())
+		if rule["id"] == resourceMap["id"] || rule["name"] == resourceMap["name"] {
+			_ = d.Set("item", []map[string]interface{}{{"rule": []map[string]interface{}{rule}}})
+			return nil
+		}
+		d.SetId("")
+		return nil
+	}
+	expected := "id:=93ad6890-8c01-11e6-996c-525400b48521\\name:=Block_Quarantine"
+	for _, importID := range []string{"Block_Quarantine", "name:Block_Quarantine", "93ad6890-8c01-11e6-996c-525400b48521"} {
+		d := resource.TestResourceData()
+		d.SetId(importID)
+		result, err := importStateGlobalRuleByIDOrName(read)(context.Background(), d, nil)
+		if err != nil {
+			t.Errorf("bad: %s, unexpected error %v", importID, err)
+			continue
+		}
+		if result[0].Id() != expected {
+			t.Errorf("bad: %s, expected ID %q, got %q", importID, expected, result[0].Id())
+		}
+	}
+}
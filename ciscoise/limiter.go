@@ -0,0 +1,81 @@
+package ciscoise
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// requestLimiter is a counting semaphore bounding the requests in flight
+// against ISE. A nil requestLimiter does not limit anything.
+type requestLimiter chan struct{}
+
+func newRequestLimiter(maxConcurrentRequests int) requestLimiter {
+	if maxConcurrentRequests <= 0 {
+		return nil
+	}
+	return make(requestLimiter, maxConcurrentRequests)
+}
+
+// acquire blocks until a slot is available or the request is cancelled.
+func (l requestLimiter) acquire(req *http.Request) error {
+	if l == nil {
+		return nil
+	}
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
+
+func (l requestLimiter) release() {
+	if l == nil {
+		return
+	}
+	<-l
+}
+
+// limitedTransport holds a limiter slot from the moment a request is sent
+// until its response body is closed, so retries and backoff waits do not
+// keep a session busy.
+type limitedTransport struct {
+	limiter requestLimiter
+	next    http.RoundTripper
+}
+
+func newLimitedTransport(limiter requestLimiter, next http.RoundTripper) http.RoundTripper {
+	if limiter == nil {
+		return next
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &limitedTransport{limiter: limiter, next: next}
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.acquire(req); err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil {
+		t.limiter.release()
+		return resp, err
+	}
+	resp.Body = &limitedBody{ReadCloser: resp.Body, release: t.limiter.release}
+	return resp, nil
+}
+
+type limitedBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *limitedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
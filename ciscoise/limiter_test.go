@@ -0,0 +1,70 @@
+package ciscoise
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingTransport struct {
+	inFlight    int32
+	maxInFlight int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	current := atomic.AddInt32(&t.inFlight, 1)
+	for {
+		max := atomic.LoadInt32(&t.maxInFlight)
+		if current <= max || atomic.CompareAndSwapInt32(&t.maxInFlight, max, current) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	atomic.AddInt32(&t.inFlight, -1)
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestLimiterBoundsConcurrentRequests(t *testing.T) {
+	tests := []struct {
+		maxConcurrentRequests int
+		requests              int
+		out                   int32
+	}{
+		{1, 5, 1},
+		{2, 6, 2},
+	}
+
+	for _, test := range tests {
+		next := &countingTransport{}
+		transport := newLimitedTransport(newRequestLimiter(test.maxConcurrentRequests), next)
+		var wg sync.WaitGroup
+		for i := 0; i < test.requests; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req, _ := http.NewRequest(http.MethodGet, "https://ise.example.com/ers/config/sgt", nil)
+				resp, err := transport.RoundTrip(req)
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+					return
+				}
+				resp.Body.Close()
+			}()
+		}
+		wg.Wait()
+		if next.maxInFlight > test.out {
+			t.Errorf("Mismatch on val %#v: expected at most %#v requests in flight but got %#v", test.maxConcurrentRequests, test.out, next.maxInFlight)
+		}
+	}
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	next := &countingTransport{}
+	if newLimitedTransport(newRequestLimiter(0), next) != next {
+		t.Errorf("Expected the transport to be left untouched when the limit is 0")
+	}
+}
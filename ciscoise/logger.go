@@ -1,6 +1,7 @@
 package ciscoise
 
 import (
+	"fmt"
 	"log"
 	"os"
 )
@@ -15,13 +16,13 @@ type logger struct {
 }
 
 func (l *logger) Errorf(format string, v ...interface{}) {
-	log.Printf("[ERROR] [RESTY] "+format, v...)
+	log.Printf("[ERROR] [RESTY] %s", redactSecrets(fmt.Sprintf(format, v...)))
 }
 
 func (l *logger) Warnf(format string, v ...interface{}) {
-	log.Printf("[WARN] [RESTY] "+format, v...)
+	log.Printf("[WARN] [RESTY] %s", redactSecrets(fmt.Sprintf(format, v...)))
 }
 
 func (l *logger) Debugf(format string, v ...interface{}) {
-	log.Printf("[DEBUG] [RESTY] "+format, v...)
+	log.Printf("[DEBUG] [RESTY] %s", redactSecrets(fmt.Sprintf(format, v...)))
 }
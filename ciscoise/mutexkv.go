@@ -0,0 +1,64 @@
+package ciscoise
+
+import (
+	"log"
+	"sync"
+)
+
+// mutexKV is a set of mutexes identified by key, so operations on the same
+// object run one at a time while operations on different objects run in
+// parallel.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock locks the mutex of key, waiting until it is available.
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock unlocks the mutex of key.
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
+// policySetMutexKV serializes the changes to the rules of each policy set.
+// ISE shifts the rank of the other rules of a set when one is created, moved
+// or deleted, so parallel changes in the same set race on rank.
+var policySetMutexKV = newMutexKV()
+
+// Keys of the global exception rules, which do not belong to a policy set.
+const (
+	networkAccessGlobalExceptionRulesKey = "network-access/global-exception"
+	deviceAdminGlobalExceptionRulesKey   = "device-admin/global-exception"
+)
+
+// sgtValuePoolMutexKV serializes the allocation of security group values
+// from value_pool, so SGTs created in parallel never pick the same value.
+var sgtValuePoolMutexKV = newMutexKV()
+
+// sgtValuePoolKey is the single key of sgtValuePoolMutexKV. Pools may
+// overlap, so all the allocations share one lock.
+const sgtValuePoolKey = "sgt/value-pool"
@@ -0,0 +1,60 @@
+package ciscoise
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMutexKVSerializesSameKey(t *testing.T) {
+	mutexKV := newMutexKV()
+	running := map[string]int{}
+	maxRunning := map[string]int{}
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		key := "policy-a"
+		if i%2 == 1 {
+			key = "policy-b"
+		}
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			mutexKV.Lock(key)
+			defer mutexKV.Unlock(key)
+			lock.Lock()
+			running[key]++
+			if running[key] > maxRunning[key] {
+				maxRunning[key] = running[key]
+			}
+			lock.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			lock.Lock()
+			running[key]--
+			lock.Unlock()
+		}(key)
+	}
+	wg.Wait()
+	for key, max := range maxRunning {
+		if max != 1 {
+			t.Errorf("expected the operations on %s to run one at a time, got %d at once", key, max)
+		}
+	}
+}
+
+func TestMutexKVParallelKeys(t *testing.T) {
+	mutexKV := newMutexKV()
+	mutexKV.Lock("policy-a")
+	defer mutexKV.Unlock("policy-a")
+	done := make(chan struct{})
+	go func() {
+		mutexKV.Lock("policy-b")
+		mutexKV.Unlock("policy-b")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("expected a different key not to wait for policy-a")
+	}
+}
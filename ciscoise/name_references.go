@@ -0,0 +1,130 @@
+package ciscoise
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+// nameReference is an attribute naming an ISE object, accepted instead of
+// the attribute holding its id. The name is resolved to the id on Create and
+// Update, and kept on Read while it still resolves to the id on ISE.
+type nameReference struct {
+	// nameKey is the name attribute, relative to the parameters block.
+	nameKey string
+	// idKey is the id attribute, relative to the parameters block.
+	idKey   string
+	resolve func(client *isegosdk.Client, name string) (string, error)
+}
+
+var (
+	sourceSgtNameReference = nameReference{
+		nameKey: "source_sgt_name",
+		idKey:   "source_sgt_id",
+		resolve: resolveSecurityGroupID,
+	}
+	destinationSgtNameReference = nameReference{
+		nameKey: "destination_sgt_name",
+		idKey:   "destination_sgt_id",
+		resolve: resolveSecurityGroupID,
+	}
+	sgaclNamesReference = nameReference{
+		nameKey: "sgacl_names",
+		idKey:   "sgacls",
+		resolve: resolveSecurityGroupsACLID,
+	}
+)
+
+// expandID returns the id of the name set in key.nameKey, empty when the
+// name attribute is not set.
+func (r nameReference) expandID(client *isegosdk.Client, key string, d *schema.ResourceData) (string, error) {
+	v, ok := d.GetOk(key + "." + r.nameKey)
+	if !ok {
+		return "", nil
+	}
+	return r.resolve(client, interfaceToString(v))
+}
+
+// expandIDs returns the ids of the names listed in key.nameKey, nil when the
+// name attribute is not set.
+func (r nameReference) expandIDs(client *isegosdk.Client, key string, d *schema.ResourceData) ([]string, error) {
+	v, ok := d.GetOk(key + "." + r.nameKey)
+	if !ok {
+		return nil, nil
+	}
+	ids := []string{}
+	for _, name := range interfaceToSliceString(v) {
+		id, err := r.resolve(client, name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// flatten sets the name attribute of the flattened item to its prior value
+// when it still resolves to the id read from ISE, so a changed object on ISE
+// shows as a diff.
+func (r nameReference) flatten(client *isegosdk.Client, key string, d *schema.ResourceData, item []map[string]interface{}) {
+	if len(item) == 0 {
+		return
+	}
+	v, ok := d.GetOk(key + "." + r.nameKey)
+	if !ok {
+		return
+	}
+	var ids interface{}
+	var err error
+	if _, ok := v.([]interface{}); ok {
+		ids, err = r.expandIDs(client, key, d)
+	} else {
+		ids, err = r.expandID(client, key, d)
+	}
+	if err != nil {
+		log.Printf("[WARN] Unable to resolve %s: %v", r.nameKey, err)
+		item[0][r.nameKey] = v
+		return
+	}
+	if reflect.DeepEqual(ids, item[0][r.idKey]) {
+		item[0][r.nameKey] = v
+	}
+}
+
+func resolveSecurityGroupID(client *isegosdk.Client, name string) (string, error) {
+	queryParams := isegosdk.GetSecurityGroupsQueryParams{}
+	queryParams.Filter = []string{fmt.Sprintf("name.EQ.%s", name)}
+	response, _, err := client.SecurityGroups.GetSecurityGroups(&queryParams)
+	if err != nil {
+		return "", fmt.Errorf("unable to look up the security group %s: %v", name, err)
+	}
+	if response != nil && response.SearchResult != nil && response.SearchResult.Resources != nil {
+		for _, item := range *response.SearchResult.Resources {
+			if item.Name == name {
+				return item.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("security group %s not found", name)
+}
+
+func resolveSecurityGroupsACLID(client *isegosdk.Client, name string) (string, error) {
+	queryParams := isegosdk.GetSecurityGroupsACLQueryParams{}
+	queryParams.Filter = []string{fmt.Sprintf("name.EQ.%s", name)}
+	response, _, err := client.SecurityGroupsACLs.GetSecurityGroupsACL(&queryParams)
+	if err != nil {
+		return "", fmt.Errorf("unable to look up the security group ACL %s: %v", name, err)
+	}
+	if response != nil && response.SearchResult != nil && response.SearchResult.Resources != nil {
+		for _, item := range *response.SearchResult.Resources {
+			if item.Name == name {
+				return item.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("security group ACL %s not found", name)
+}
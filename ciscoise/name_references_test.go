@@ -0,0 +1,82 @@
+package ciscoise
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+// testNameReferenceClient returns a client of an ISE with the security
+// groups and security group ACLs in names, a map of name to id.
+func testNameReferenceClient(t *testing.T, names map[string]string) *isegosdk.Client {
+	return newTestClientConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		resources := []interface{}{}
+		filter := strings.TrimPrefix(r.URL.Query().Get("filter"), "name.EQ.")
+		if id, ok := names[filter]; ok && (r.URL.Path == "/ers/config/sgt" || r.URL.Path == "/ers/config/sgacl") {
+			resources = append(resources, map[string]interface{}{"id": id, "name": filter})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"SearchResult": map[string]interface{}{"total": len(resources), "resources": resources},
+		})
+	}).Client
+}
+
+func TestEgressMatrixCellNameReferences(t *testing.T) {
+	client := testNameReferenceClient(t, map[string]string{
+		"Employees":  "sgt-employees",
+		"Servers":    "sgt-servers",
+		"Permit_IP":  "sgacl-permit",
+		"Deny_Guest": "sgacl-deny",
+	})
+	d := schema.TestResourceDataRaw(t, resourceEgressMatrixCell().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"source_sgt_name":      "Employees",
+				"destination_sgt_name": "Servers",
+				"sgacl_names":          []interface{}{"Permit_IP", "Deny_Guest"},
+			},
+		},
+	})
+	sourceSgtID, destinationSgtID, sgacls, err := expandEgressMatrixCellNameReferences(client, "parameters.0", d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if sourceSgtID != "sgt-employees" || destinationSgtID != "sgt-servers" {
+		t.Errorf("expected the security group ids, got %s and %s", sourceSgtID, destinationSgtID)
+	}
+	if expected := []string{"sgacl-permit", "sgacl-deny"}; !reflect.DeepEqual(sgacls, expected) {
+		t.Errorf("expected sgacls %v, got %v", expected, sgacls)
+	}
+
+	item := []map[string]interface{}{{
+		"source_sgt_id":      "sgt-employees",
+		"destination_sgt_id": "sgt-other",
+		"sgacls":             []string{"sgacl-permit", "sgacl-deny"},
+	}}
+	flattenEgressMatrixCellNameReferences(client, d, item)
+	if item[0]["source_sgt_name"] != "Employees" {
+		t.Errorf("expected source_sgt_name to be kept, got %v", item[0]["source_sgt_name"])
+	}
+	if _, ok := item[0]["destination_sgt_name"]; ok {
+		t.Errorf("expected destination_sgt_name to be dropped when it resolves to another id")
+	}
+	if item[0]["sgacl_names"] == nil {
+		t.Errorf("expected sgacl_names to be kept")
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceEgressMatrixCell().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{"source_sgt_name": "Contractors"},
+		},
+	})
+	if _, _, _, err := expandEgressMatrixCellNameReferences(client, "parameters.0", d); err == nil {
+		t.Errorf("expected an error for an unknown security group")
+	}
+}
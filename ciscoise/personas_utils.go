@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"log"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
@@ -33,21 +34,22 @@ type Node struct {
 }
 
 // *********************************************Node Methods*******************************************************
-func (node Node) IsStandAlone() (bool, error) {
-	path := fmt.Sprintf("https://%s/api/v1/deployment/node/%s", node.Ip, node.HostName)
+func (node Node) IsStandAlone(clients *nodeClients) (bool, error) {
+	path := fmt.Sprintf("/api/v1/deployment/node/%s", node.HostName)
 
 	log.Printf("[DEBUG] My Path %s", path)
 
-	response, resty, err := customGetNode(path, node.UserName, node.Password, true)
+	resty, err := clients.get(node, path, &NodeR{})
 
-	if err != nil || response == nil {
+	if err != nil || resty == nil {
 		if resty != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", resty.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(resty.String()))
 		}
 		return false, err
 	}
+	response := resty.Result().(*NodeR)
 
-	for _, role := range response.Roles {
+	for _, role := range response.Response.Roles {
 		if strings.ToUpper(role) == "STANDALONE" {
 			return true, err
 		}
@@ -55,13 +57,13 @@ func (node Node) IsStandAlone() (bool, error) {
 	return false, err
 }
 
-func (node Node) AppServerIsRunning() (bool, error) {
-	path := fmt.Sprintf("https://%s/ers/config/op/systemconfig/iseversion", node.Ip)
-	_, resty, err := customGet(path, node.UserName, node.Password, false)
+func (node Node) AppServerIsRunning(clients *nodeClients) (bool, error) {
+	path := "/ers/config/op/systemconfig/iseversion"
+	resty, err := clients.get(node, path, nil)
 
 	if err != nil {
 		if resty != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", resty.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(resty.String()))
 		}
 		return false, err
 	}
@@ -69,13 +71,13 @@ func (node Node) AppServerIsRunning() (bool, error) {
 	return true, err
 }
 
-func (node Node) ReturnIdOfCertificate() (*string, error) {
-	path := fmt.Sprintf("https://%s/api/v1/certs/system-certificate/%s", node.Ip, node.HostName)
-	_, resty, err := customGetCerts(path, node.UserName, node.Password, false)
+func (node Node) ReturnIdOfCertificate(clients *nodeClients) (*string, error) {
+	path := fmt.Sprintf("/api/v1/certs/system-certificate/%s", node.HostName)
+	resty, err := clients.get(node, path, &isegosdk.ResponseCertificatesGetSystemCertificates{})
 
 	if err != nil {
 		if resty != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", resty.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(resty.String()))
 		}
 		return nil, err
 	}
@@ -95,8 +97,8 @@ func (node Node) ReturnIdOfCertificate() (*string, error) {
 	return nil, err
 }
 
-func (node Node) RegisterToPrimary(primary Node) error {
-	path := fmt.Sprintf("https://%s/api/v1/deployment/node", primary.Ip)
+func (node Node) RegisterToPrimary(clients *nodeClients, primary Node) error {
+	path := "/api/v1/deployment/node"
 	allow := true
 	request := isegosdk.RequestNodeDeploymentRegisterNode{
 		Fqdn:            node.Fqdn,
@@ -106,35 +108,38 @@ func (node Node) RegisterToPrimary(primary Node) error {
 		Roles:           node.Roles,
 		Services:        node.Services,
 	}
-	_, err := customPost(path, node.UserName, node.Password, request)
+	_, err := clients.post(primary, path, request)
 
 	return err
 }
 
-func (node Node) UpdateRolesServices() error {
-	path := fmt.Sprintf("https://%s/api/v1/deployment/node", node.Ip)
+func (node Node) UpdateRolesServices(clients *nodeClients) error {
+	path := "/api/v1/deployment/node"
 	request := isegosdk.RequestNodeDeploymentRegisterNode{}
 	request.Roles = node.Roles
 	request.Services = node.Services
-	_, err := customPut(path, node.UserName, node.HostName, request)
+	_, err := clients.put(node, path, request)
 
 	return err
 }
 
-func (node Node) ImportCertificateIntoPrimary(primary Node) error {
+func (node Node) ImportCertificateIntoPrimary(clients *nodeClients, primary Node) error {
 	log.Printf("[DEBUG] ImportCertificateIntoPrimary 1")
-	certId, err := node.ReturnIdOfCertificate()
-	if err != nil && certId != nil {
+	certId, err := node.ReturnIdOfCertificate(clients)
+	if err != nil {
 		return err
 	}
+	if certId == nil {
+		return fmt.Errorf("Default self-signed server certificate of %s not found", node.HostName)
+	}
 	exportRequest := isegosdk.RequestCertificatesExportSystemCert{
 		ID:     *certId,
 		Export: "CERTIFICATE",
 	}
 
-	path := fmt.Sprintf("https://%s/api/v1/certs/system-certificate/export", node.Ip)
+	path := "/api/v1/certs/system-certificate/export"
 
-	response, err := customPost(path, node.UserName, node.Password, exportRequest)
+	response, err := clients.post(node, path, exportRequest)
 
 	fdownload := isegosdk.FileDownload{}
 	if err != nil {
@@ -178,8 +183,8 @@ func (node Node) ImportCertificateIntoPrimary(primary Node) error {
 		Name:                              node.Name,
 		ValidateCertificateExtensions:     &validateCertificateExtensions,
 	}
-	path = fmt.Sprintf("https://%s/api/v1/certs/trusted-certificate/import", primary.Ip)
-	_, err = customPost(path, node.UserName, node.Password, request)
+	path = "/api/v1/certs/trusted-certificate/import"
+	_, err = clients.post(primary, path, request)
 	if err != nil {
 		return err
 	}
@@ -187,14 +192,12 @@ func (node Node) ImportCertificateIntoPrimary(primary Node) error {
 	return err
 }
 
-func (node Node) PromoteToPrimary() error {
-	path := fmt.Sprintf("https://%s/api/v1/deployment/primary", node.Ip)
+func (node Node) PromoteToPrimary(clients *nodeClients) error {
+	path := "/api/v1/deployment/primary"
 
-	_, err := customPostWithNoBody(path, node.UserName, node.Password)
-	if err != nil {
-		if err.Error() == "error with operation customPost." {
-			return fmt.Errorf("Could not update node to PRIMARY")
-		}
+	response, err := clients.post(node, path, nil)
+	if err != nil && response != nil && response.IsError() {
+		return fmt.Errorf("Could not update node to PRIMARY. %s", response)
 	}
 	return err
 }
@@ -212,138 +215,68 @@ func decodeUtf8(b []byte) string {
 }
 
 // *********************************************API FUNCS********************************************************
-func customGet(path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
-	response, err := client.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetError(&Error).
-		Get(path)
-	if err != nil {
-		return nil, nil, err
 
-	}
-	if response.IsError() {
-		return nil, response, fmt.Errorf("error with operation customGet. %s", response)
-	}
-
-	return nil, response, err
+// nodeClients builds resty clients for the nodes of the deployment, which are
+// addressed by their own IP and credentials instead of the provider base_url.
+// Every client gets the provider TLS, debug, timeout, retry and concurrency
+// settings.
+type nodeClients struct {
+	config    Config
+	tlsConfig *tls.Config
 }
 
-func customGetCerts(path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
-	response, err := client.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetResult(&isegosdk.ResponseCertificatesGetSystemCertificates{}).
-		SetError(&Error).
-		Get(path)
+func newNodeClients(config Config) (*nodeClients, error) {
+	tlsConfig, err := config.tlsConfig()
 	if err != nil {
-		return nil, nil, err
-
-	}
-
-	if response.IsError() {
-		return nil, response, fmt.Errorf("error with operation customGet. %s", response)
+		return nil, err
 	}
-
-	return nil, response, err
+	return &nodeClients{config: config, tlsConfig: personasTLSConfig(tlsConfig)}, nil
 }
 
-func customGetNode(path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
+// client returns a resty client for node, authenticated with its credentials.
+func (c *nodeClients) client(node Node) *resty.Client {
 	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
-	response, err := client.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetResult(&NodeR{}).
-		SetError(&Error).
-		Get(path)
-	if err != nil {
-		return nil, nil, err
-
+	client.SetDebug(c.config.Debug == "true")
+	client.SetLogger(createLogger())
+	client.SetTLSClientConfig(c.tlsConfig)
+	if c.config.RequestTimeout > 0 {
+		client.SetTimeout(time.Duration(c.config.RequestTimeout) * time.Second)
 	}
-
-	if response.IsError() {
-		return nil, response, fmt.Errorf("error with operation customGet. %s", response)
-	}
-
-	var result *NodeR
-	if castResult {
-		result = response.Result().(*NodeR)
-	}
-	return &result.Response, response, err
+	c.config.configureRetries(client)
+	client.SetTransport(newLimitedTransport(c.config.limiter, client.GetClient().Transport))
+	client.SetBaseURL(fmt.Sprintf("https://%s", node.Ip))
+	client.SetBasicAuth(node.UserName, node.Password)
+	client.SetHeader("Content-Type", "application/json")
+	client.SetHeader("Accept", "application/json")
+	return client
 }
 
-func customPost(path string, username string, password string, requestBody interface{}) (*resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
-	response, err := client.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetBody(requestBody).
-		SetError(&Error).
-		Post(path)
-	if err != nil {
-		return nil, err
-
+func (c *nodeClients) execute(node Node, method string, path string, requestBody interface{}, result interface{}) (*resty.Response, error) {
+	request := c.client(node).R().SetError(&Error)
+	if requestBody != nil {
+		request.SetBody(requestBody)
 	}
-
-	if response.IsError() {
-		return response, fmt.Errorf("error with operation customPost.")
+	if result != nil {
+		request.SetResult(result)
 	}
-	return response, err
-}
-
-func customPostWithNoBody(path string, username string, password string) (*resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
-	response, err := client.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetError(&Error).
-		Post(path)
+	response, err := request.Execute(method, path)
 	if err != nil {
 		return nil, err
-
 	}
-
 	if response.IsError() {
-		return response, fmt.Errorf("error with operation customPost.")
+		return response, fmt.Errorf("error with operation %s %s on %s. %s", method, path, node.Ip, response)
 	}
 	return response, err
 }
 
-func customPut(path string, username string, password string, requestBody interface{}) (*resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
-	response, err := client.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetBody(requestBody).
-		SetError(&Error).
-		Put(path)
-	if err != nil {
-		return nil, err
+func (c *nodeClients) get(node Node, path string, result interface{}) (*resty.Response, error) {
+	return c.execute(node, resty.MethodGet, path, nil, result)
+}
 
-	}
+func (c *nodeClients) post(node Node, path string, requestBody interface{}) (*resty.Response, error) {
+	return c.execute(node, resty.MethodPost, path, requestBody, nil)
+}
 
-	if response.IsError() {
-		return response, fmt.Errorf("error with operation customPut.")
-	}
-	return response, err
+func (c *nodeClients) put(node Node, path string, requestBody interface{}) (*resty.Response, error) {
+	return c.execute(node, resty.MethodPut, path, requestBody, nil)
 }
@@ -0,0 +1,77 @@
+package ciscoise
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPersonasNodeClientsCredentials(t *testing.T) {
+	type call struct {
+		method   string
+		path     string
+		username string
+		password string
+	}
+	calls := []call{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		calls = append(calls, call{r.Method, r.URL.Path, username, password})
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"response": {"hostname": "ise-01", "roles": ["Standalone"]}}`))
+	}))
+	defer server.Close()
+
+	clients, err := newNodeClients(Config{SSLVerify: "false", RequestTimeout: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	node := Node{
+		Ip:       strings.TrimPrefix(server.URL, "https://"),
+		HostName: "ise-01",
+		UserName: "admin",
+		Password: "node-password",
+		Roles:    []string{"SecondaryAdmin"},
+	}
+
+	isStandAlone, err := node.IsStandAlone(clients)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !isStandAlone {
+		t.Errorf("Expected node %s to be in STANDALONE mode", node.HostName)
+	}
+	if err := node.UpdateRolesServices(clients); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []call{
+		{http.MethodGet, "/api/v1/deployment/node/ise-01", "admin", "node-password"},
+		{http.MethodPut, "/api/v1/deployment/node", "admin", "node-password"},
+	}
+	if len(calls) != len(expected) {
+		t.Fatalf("Mismatch on calls: expected %#v but got %#v", expected, calls)
+	}
+	for i := range expected {
+		if calls[i] != expected[i] {
+			t.Errorf("Mismatch on call %d: expected %#v but got %#v", i, expected[i], calls[i])
+		}
+	}
+}
+
+func TestPersonasNodeClientsVerifyCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	clients, err := newNodeClients(Config{SSLVerify: "true"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	node := Node{Ip: strings.TrimPrefix(server.URL, "https://"), UserName: "admin", Password: "node-password"}
+	if _, err := node.AppServerIsRunning(clients); err == nil {
+		t.Errorf("Expected the self-signed certificate of the node to be rejected when ssl_verify is true")
+	}
+}
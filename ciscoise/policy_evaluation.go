@@ -0,0 +1,293 @@
+package ciscoise
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// conditionResult is the outcome of evaluating a condition tree against a
+// set of dictionary attributes. Conditions the evaluator does not support
+// are indeterminate rather than guessed.
+type conditionResult int
+
+const (
+	conditionFalse conditionResult = iota
+	conditionTrue
+	conditionIndeterminate
+)
+
+// policyEvaluator evaluates condition trees, in the JSON format of the ISE
+// API, against attributes keyed by Dictionary:Attribute.
+type policyEvaluator struct {
+	attributes map[string]string
+	// conditions are the library conditions, by id and by name.
+	conditions map[string]map[string]interface{}
+}
+
+func newPolicyEvaluator(attributes map[string]string, conditions []map[string]interface{}) *policyEvaluator {
+	e := &policyEvaluator{
+		attributes: attributes,
+		conditions: map[string]map[string]interface{}{},
+	}
+	for _, condition := range conditions {
+		if id := conditionString(condition, "id"); id != "" {
+			e.conditions[id] = condition
+		}
+		if name := conditionString(condition, "name"); name != "" {
+			e.conditions[name] = condition
+		}
+	}
+	return e
+}
+
+// evaluate returns the result of condition and, when it is indeterminate,
+// the reason. A missing condition is true, like the default rules.
+func (e *policyEvaluator) evaluate(v interface{}) (conditionResult, string) {
+	return e.evaluateCondition(v, map[string]bool{})
+}
+
+func (e *policyEvaluator) evaluateCondition(v interface{}, visiting map[string]bool) (conditionResult, string) {
+	if v == nil {
+		return conditionTrue, ""
+	}
+	condition, ok := v.(map[string]interface{})
+	if !ok {
+		return conditionIndeterminate, "condition must be an object"
+	}
+	var result conditionResult
+	var reason string
+	conditionType := strings.TrimPrefix(conditionString(condition, "conditionType"), "Library")
+	switch conditionType {
+	case "ConditionAndBlock", "ConditionOrBlock":
+		result, reason = e.evaluateBlock(condition, conditionType == "ConditionAndBlock", visiting)
+	case "ConditionReference":
+		result, reason = e.evaluateReference(condition, visiting)
+	case "ConditionAttributes":
+		result, reason = e.evaluateAttributes(condition)
+	default:
+		return conditionIndeterminate, fmt.Sprintf("%s conditions are not supported", conditionType)
+	}
+	if condition["isNegate"] == true && result != conditionIndeterminate {
+		result = conditionTrue - result
+	}
+	return result, reason
+}
+
+// evaluateBlock combines the children with three-valued logic: a false
+// child decides an AND block and a true child an OR block, even when
+// other children are indeterminate.
+func (e *policyEvaluator) evaluateBlock(condition map[string]interface{}, and bool, visiting map[string]bool) (conditionResult, string) {
+	children, _ := condition["children"].([]interface{})
+	if len(children) == 0 {
+		return conditionIndeterminate, "block without children"
+	}
+	decisive := conditionTrue
+	if and {
+		decisive = conditionFalse
+	}
+	result := conditionTrue - decisive
+	reason := ""
+	for _, child := range children {
+		childResult, childReason := e.evaluateCondition(child, visiting)
+		if childResult == decisive {
+			return decisive, ""
+		}
+		if childResult == conditionIndeterminate && result != conditionIndeterminate {
+			result, reason = conditionIndeterminate, childReason
+		}
+	}
+	return result, reason
+}
+
+func (e *policyEvaluator) evaluateReference(condition map[string]interface{}, visiting map[string]bool) (conditionResult, string) {
+	reference := conditionString(condition, "id")
+	if reference == "" {
+		reference = conditionString(condition, "name")
+	}
+	library, ok := e.conditions[reference]
+	if !ok {
+		return conditionIndeterminate, fmt.Sprintf("library condition %s not found", reference)
+	}
+	if visiting[reference] {
+		return conditionIndeterminate, fmt.Sprintf("library condition %s references itself", reference)
+	}
+	visiting[reference] = true
+	defer delete(visiting, reference)
+	// The negation of the reference applies on top of the library condition's.
+	return e.evaluateCondition(library, visiting)
+}
+
+func (e *policyEvaluator) evaluateAttributes(condition map[string]interface{}) (conditionResult, string) {
+	key := conditionString(condition, "dictionaryName") + ":" + conditionString(condition, "attributeName")
+	operator := conditionString(condition, "operator")
+	if conditionString(condition, "dictionaryValue") != "" {
+		return conditionIndeterminate, fmt.Sprintf("%s is compared to another attribute", key)
+	}
+	value, ok := e.attributes[key]
+	if !ok {
+		return conditionFalse, ""
+	}
+	result, err := evaluateConditionOperator(operator, value, conditionString(condition, "attributeValue"))
+	if err != nil {
+		return conditionIndeterminate, fmt.Sprintf("%s %s: %v", key, operator, err)
+	}
+	if result {
+		return conditionTrue, ""
+	}
+	return conditionFalse, ""
+}
+
+// evaluateConditionOperator compares value, the attribute of the request,
+// to expected, the value of the condition. It fails for the operators it
+// does not support and the values it can not compare.
+func evaluateConditionOperator(operator string, value string, expected string) (bool, error) {
+	switch operator {
+	case "equals":
+		return value == expected, nil
+	case "notEquals":
+		return value != expected, nil
+	case "contains":
+		return strings.Contains(value, expected), nil
+	case "notContains":
+		return !strings.Contains(value, expected), nil
+	case "startsWith":
+		return strings.HasPrefix(value, expected), nil
+	case "notStartsWith":
+		return !strings.HasPrefix(value, expected), nil
+	case "endsWith":
+		return strings.HasSuffix(value, expected), nil
+	case "notEndsWith":
+		return !strings.HasSuffix(value, expected), nil
+	case "matches":
+		re, err := regexp.Compile(expected)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression %q", expected)
+		}
+		return re.MatchString(value), nil
+	case "greaterThan", "greaterOrEquals", "lessThan", "lessOrEquals":
+		a, errA := strconv.ParseFloat(value, 64)
+		b, errB := strconv.ParseFloat(expected, 64)
+		if errA != nil || errB != nil {
+			return false, fmt.Errorf("%q and %q are not both numbers", value, expected)
+		}
+		switch operator {
+		case "greaterThan":
+			return a > b, nil
+		case "greaterOrEquals":
+			return a >= b, nil
+		case "lessThan":
+			return a < b, nil
+		}
+		return a <= b, nil
+	case "ipEquals", "ipNotEquals":
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return false, fmt.Errorf("%q is not an IP address", value)
+		}
+		var match bool
+		if _, network, err := net.ParseCIDR(expected); err == nil {
+			match = network.Contains(ip)
+		} else if expectedIP := net.ParseIP(expected); expectedIP != nil {
+			match = ip.Equal(expectedIP)
+		} else {
+			return false, fmt.Errorf("%q is not an IP address or network", expected)
+		}
+		return match == (operator == "ipEquals"), nil
+	}
+	return false, fmt.Errorf("operator not supported")
+}
+
+// policyEvaluationMatch is the outcome of evaluating an ordered list of
+// policy sets or rules: the first one whose condition is true, or the first
+// one whose condition is indeterminate, since it may match before the
+// others.
+type policyEvaluationMatch struct {
+	object map[string]interface{}
+	result conditionResult
+	reason string
+}
+
+// evaluatePolicyObjects evaluates entries, policy sets or the rule objects
+// of policy rules, in rank order with the default one last. Disabled
+// entries and entries in monitor mode, whose result ISE does not apply, are
+// skipped. It returns nil when no entry matches.
+func (e *policyEvaluator) evaluatePolicyObjects(entries []map[string]interface{}) *policyEvaluationMatch {
+	for _, entry := range sortPolicyObjects(entries) {
+		if !isPolicyObjectApplied(entry) {
+			continue
+		}
+		result, reason := e.evaluate(entry["condition"])
+		if result == conditionFalse {
+			continue
+		}
+		return &policyEvaluationMatch{object: entry, result: result, reason: reason}
+	}
+	return nil
+}
+
+// sortPolicyObjects returns entries, policy sets or the rule objects of
+// policy rules, in rank order with the default one last.
+func sortPolicyObjects(entries []map[string]interface{}) []map[string]interface{} {
+	sorted := append([]map[string]interface{}{}, entries...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if (sorted[i]["default"] == true) != (sorted[j]["default"] == true) {
+			return sorted[j]["default"] == true
+		}
+		rankI, _ := sorted[i]["rank"].(float64)
+		rankJ, _ := sorted[j]["rank"].(float64)
+		return rankI < rankJ
+	})
+	return sorted
+}
+
+// isPolicyObjectApplied reports whether ISE applies the result of a policy
+// set or rule, which it does not for disabled ones and ones in monitor mode.
+func isPolicyObjectApplied(entry map[string]interface{}) bool {
+	state := conditionString(entry, "state")
+	return state != "disabled" && state != "monitor"
+}
+
+// decodePolicyObjects returns the objects in the response attribute of a
+// raw policy API response, or err, the error of the request.
+func decodePolicyObjects(restyResp *resty.Response, err error) ([]map[string]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	if restyResp == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+	response := struct {
+		Response []map[string]interface{} `json:"response"`
+	}{}
+	if err := json.Unmarshal(restyResp.Body(), &response); err != nil {
+		return nil, err
+	}
+	return response.Response, nil
+}
+
+// policyRuleObjects returns the rule objects of policy rules, with the rule
+// results merged in so they can be read from the matched object.
+func policyRuleObjects(entries []map[string]interface{}) []map[string]interface{} {
+	rules := []map[string]interface{}{}
+	for _, entry := range entries {
+		rule := ruleListEntry(entry).rule()
+		object := map[string]interface{}{}
+		for k, v := range entry {
+			if k != "rule" {
+				object[k] = v
+			}
+		}
+		for k, v := range rule {
+			object[k] = v
+		}
+		rules = append(rules, object)
+	}
+	return rules
+}
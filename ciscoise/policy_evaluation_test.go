@@ -0,0 +1,137 @@
+package ciscoise
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testPolicyEvaluator() *policyEvaluator {
+	conditions := []map[string]interface{}{}
+	_ = json.Unmarshal([]byte(`[
+	  {"id":"c1","name":"Wireless_802.1X","conditionType":"LibraryConditionAndBlock","children":[
+	    {"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"NAS-Port-Type","operator":"equals","attributeValue":"Wireless - IEEE 802.11"},
+	    {"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"Service-Type","operator":"equals","attributeValue":"Framed"}
+	  ]},
+	  {"id":"c2","name":"Loop","conditionType":"LibraryConditionOrBlock","children":[{"conditionType":"ConditionReference","id":"c2"}]}
+	]`), &conditions)
+	return newPolicyEvaluator(map[string]string{
+		"Radius:NAS-Port-Type":     "Wireless - IEEE 802.11",
+		"Radius:Service-Type":      "Framed",
+		"Radius:Called-Station-ID": "00-11-22-33-44-55:Corp",
+		"Radius:NAS-IP-Address":    "10.1.2.3",
+		"EndPoints:LogicalProfile": "Printers",
+	}, conditions)
+}
+
+func TestPolicyEvaluatorEvaluate(t *testing.T) {
+	cases := map[string]struct {
+		Condition string
+		Expected  conditionResult
+	}{
+		"no condition": {
+			Condition: `null`,
+			Expected:  conditionTrue,
+		},
+		"library condition": {
+			Condition: `{"conditionType":"ConditionReference","id":"c1"}`,
+			Expected:  conditionTrue,
+		},
+		"negated library condition by name": {
+			Condition: `{"conditionType":"ConditionReference","name":"Wireless_802.1X","isNegate":true}`,
+			Expected:  conditionFalse,
+		},
+		"unknown library condition": {
+			Condition: `{"conditionType":"ConditionReference","id":"c9"}`,
+			Expected:  conditionIndeterminate,
+		},
+		"cyclic library condition": {
+			Condition: `{"conditionType":"ConditionReference","id":"c2"}`,
+			Expected:  conditionIndeterminate,
+		},
+		"ends with": {
+			Condition: `{"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"Called-Station-ID","operator":"endsWith","attributeValue":":Corp"}`,
+			Expected:  conditionTrue,
+		},
+		"missing attribute": {
+			Condition: `{"conditionType":"ConditionAttributes","dictionaryName":"IdentityGroup","attributeName":"Name","operator":"equals","attributeValue":"Guests"}`,
+			Expected:  conditionFalse,
+		},
+		"ip in network": {
+			Condition: `{"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"NAS-IP-Address","operator":"ipEquals","attributeValue":"10.1.0.0/16"}`,
+			Expected:  conditionTrue,
+		},
+		"unsupported operator": {
+			Condition: `{"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"Called-Station-ID","operator":"macEquals","attributeValue":"00:11:22:33:44:55"}`,
+			Expected:  conditionIndeterminate,
+		},
+		"time condition": {
+			Condition: `{"conditionType":"TimeAndDateCondition","datesRange":{"startDate":"2026-01-01"}}`,
+			Expected:  conditionIndeterminate,
+		},
+		"false child decides an and block": {
+			Condition: `{"conditionType":"ConditionAndBlock","children":[
+			  {"conditionType":"TimeAndDateCondition"},
+			  {"conditionType":"ConditionAttributes","dictionaryName":"EndPoints","attributeName":"LogicalProfile","operator":"equals","attributeValue":"Cameras"}
+			]}`,
+			Expected: conditionFalse,
+		},
+		"true child decides an or block": {
+			Condition: `{"conditionType":"ConditionOrBlock","children":[
+			  {"conditionType":"TimeAndDateCondition"},
+			  {"conditionType":"ConditionAttributes","dictionaryName":"EndPoints","attributeName":"LogicalProfile","operator":"equals","attributeValue":"Printers"}
+			]}`,
+			Expected: conditionTrue,
+		},
+		"indeterminate or block": {
+			Condition: `{"conditionType":"ConditionOrBlock","children":[
+			  {"conditionType":"TimeAndDateCondition"},
+			  {"conditionType":"ConditionAttributes","dictionaryName":"EndPoints","attributeName":"LogicalProfile","operator":"equals","attributeValue":"Cameras"}
+			]}`,
+			Expected: conditionIndeterminate,
+		},
+		"negated indeterminate block": {
+			Condition: `{"conditionType":"ConditionAndBlock","isNegate":true,"children":[{"conditionType":"TimeAndDateCondition"}]}`,
+			Expected:  conditionIndeterminate,
+		},
+	}
+	e := testPolicyEvaluator()
+	for tn, tc := range cases {
+		var condition interface{}
+		_ = json.Unmarshal([]byte(tc.Condition), &condition)
+		result, reason := e.evaluate(condition)
+		if result != tc.Expected {
+			t.Errorf("bad: %s, expected %d, got %d (%s)", tn, tc.Expected, result, reason)
+		}
+		if result == conditionIndeterminate && reason == "" {
+			t.Errorf("bad: %s, expected a reason for the indeterminate result", tn)
+		}
+	}
+}
+
+func TestPolicyEvaluatorEvaluatePolicyObjects(t *testing.T) {
+	entries := []map[string]interface{}{}
+	_ = json.Unmarshal([]byte(`[
+	  {"rule":{"id":"d","name":"Default","rank":3,"default":true},"profile":["DenyAccess"]},
+	  {"rule":{"id":"p","name":"Printers","rank":2,"condition":{"conditionType":"ConditionAttributes","dictionaryName":"EndPoints","attributeName":"LogicalProfile","operator":"equals","attributeValue":"Printers"}},"profile":["PermitAccess"],"securityGroup":"Printers"},
+	  {"rule":{"id":"m","name":"Monitor","rank":0,"state":"monitor"},"profile":["PermitAccess"]},
+	  {"rule":{"id":"w","name":"Wireless","rank":1,"state":"disabled","condition":{"conditionType":"ConditionReference","id":"c1"}},"profile":["PermitAccess"]}
+	]`), &entries)
+	e := testPolicyEvaluator()
+	match := e.evaluatePolicyObjects(policyRuleObjects(entries))
+	if match == nil || match.result != conditionTrue || conditionString(match.object, "name") != "Printers" {
+		t.Fatalf("expected the Printers rule to match, got %v", match)
+	}
+	if conditionString(match.object, "securityGroup") != "Printers" {
+		t.Errorf("expected the rule results to be merged in, got %v", match.object)
+	}
+
+	entries[1]["rule"].(map[string]interface{})["condition"] = map[string]interface{}{"conditionType": "TimeAndDateCondition"}
+	match = e.evaluatePolicyObjects(policyRuleObjects(entries))
+	if match == nil || match.result != conditionIndeterminate || conditionString(match.object, "name") != "Printers" {
+		t.Errorf("expected the evaluation to stop at the indeterminate rule, got %v", match)
+	}
+
+	if match := e.evaluatePolicyObjects(policyRuleObjects(entries[2:])); match != nil {
+		t.Errorf("expected the rules in monitor mode or disabled to be skipped, got %v", match.object)
+	}
+}
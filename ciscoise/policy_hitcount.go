@@ -0,0 +1,113 @@
+package ciscoise
+
+import (
+	"fmt"
+	"strings"
+)
+
+// policyHitCountRuleTypes are the rule lists of a policy set, in the order
+// ISE evaluates them.
+var policyHitCountRuleTypes = []string{"authentication_rules", "local_exception_rules", "authorization_rules"}
+
+// policyHitCountRule is a rule of the hit count report.
+type policyHitCountRule struct {
+	domain        string
+	policySetID   string
+	policySetName string
+	ruleType      string
+	rule          map[string]interface{}
+}
+
+// policyHitCountSet holds the totals of a policy set of the hit count
+// report.
+type policyHitCountSet struct {
+	domain    string
+	policySet map[string]interface{}
+	rules     int
+	ruleHits  int
+}
+
+// policyHitCountFilter selects the rules of the hit count report. A nil
+// bound is not checked.
+type policyHitCountFilter struct {
+	policySetName string
+	minHits       *int
+	maxHits       *int
+}
+
+func (f policyHitCountFilter) matches(rule policyHitCountRule) bool {
+	if f.policySetName != "" && rule.policySetName != f.policySetName {
+		return false
+	}
+	hits := policyObjectHitCounts(rule.rule)
+	if f.minHits != nil && hits < *f.minHits {
+		return false
+	}
+	if f.maxHits != nil && hits > *f.maxHits {
+		return false
+	}
+	return true
+}
+
+// hitCounts returns the policy sets of the domain by rank with the totals of
+// their rules, and the rules of the policy sets, then the global exception
+// rules, that match filter.
+func (p policySnapshotDomain) hitCounts(filter policyHitCountFilter) ([]policyHitCountSet, []policyHitCountRule, error) {
+	policySets, err := decodePolicyObjects(p.policySets())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read the %s policy sets: %v", p.name, err)
+	}
+	sets := []policyHitCountSet{}
+	rules := []policyHitCountRule{}
+	for _, policySet := range sortPolicyObjects(policySets) {
+		policyID := conditionString(policySet, "id")
+		policySetName := conditionString(policySet, "name")
+		if filter.policySetName != "" && policySetName != filter.policySetName {
+			continue
+		}
+		set := policyHitCountSet{domain: p.name, policySet: policySet}
+		for _, key := range policyHitCountRuleTypes {
+			entries, err := decodePolicyObjects(p.rules[key](policyID))
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to read the %s %s of policy set %s: %v", p.name, strings.Replace(key, "_", " ", -1), policySetName, err)
+			}
+			for _, rule := range sortPolicyObjects(policyRuleObjects(entries)) {
+				set.rules++
+				set.ruleHits += policyObjectHitCounts(rule)
+				hitCountRule := policyHitCountRule{
+					domain:        p.name,
+					policySetID:   policyID,
+					policySetName: policySetName,
+					ruleType:      strings.TrimSuffix(key, "_rules"),
+					rule:          rule,
+				}
+				if filter.matches(hitCountRule) {
+					rules = append(rules, hitCountRule)
+				}
+			}
+		}
+		sets = append(sets, set)
+	}
+	if filter.policySetName != "" {
+		return sets, rules, nil
+	}
+	entries, err := decodePolicyObjects(p.globalExceptionRules())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read the %s global exception rules: %v", p.name, err)
+	}
+	for _, rule := range sortPolicyObjects(policyRuleObjects(entries)) {
+		hitCountRule := policyHitCountRule{domain: p.name, ruleType: "global_exception", rule: rule}
+		if filter.matches(hitCountRule) {
+			rules = append(rules, hitCountRule)
+		}
+	}
+	return sets, rules, nil
+}
+
+// policyObjectHitCounts returns the hit counts of a policy set or rule.
+func policyObjectHitCounts(object map[string]interface{}) int {
+	if hits, ok := object["hitCounts"].(float64); ok {
+		return int(hits)
+	}
+	return 0
+}
@@ -0,0 +1,78 @@
+package ciscoise
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPolicyHitCounts(t *testing.T) {
+	responses := map[string]string{
+		"/api/v1/policy/network-access/policy-set": `{"response":[
+		  {"id":"d","name":"Default","rank":1,"default":true,"hitCounts":120},
+		  {"id":"p1","name":"Wired","rank":0,"hitCounts":30}
+		]}`,
+		"/api/v1/policy/network-access/policy-set/global-exception": `{"response":[
+		  {"rule":{"id":"g1","name":"Blocked","rank":0,"hitCounts":0}}
+		]}`,
+		"/api/v1/policy/network-access/policy-set/p1/authentication": `{"response":[
+		  {"rule":{"id":"a1","name":"Default","rank":0,"default":true,"hitCounts":30},"identitySourceName":"All_User_ID_Stores"}
+		]}`,
+		"/api/v1/policy/network-access/policy-set/p1/authorization": `{"response":[
+		  {"rule":{"id":"r3","name":"Default","rank":2,"default":true,"hitCounts":5},"profile":["DenyAccess"]},
+		  {"rule":{"id":"r2","name":"Cameras","rank":1,"hitCounts":0},"profile":["PermitAccess"]},
+		  {"rule":{"id":"r1","name":"Printers","rank":0,"hitCounts":25},"profile":["PermitAccess"]}
+		]}`,
+	}
+	client := newTestClientConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			response = `{"response":[]}`
+		}
+		_, _ = w.Write([]byte(response))
+	}).Client
+
+	zero, five := 0, 5
+	cases := map[string]struct {
+		Filter        policyHitCountFilter
+		ExpectedRules []string
+		ExpectedSets  []string
+	}{
+		"no filter": {
+			Filter:        policyHitCountFilter{},
+			ExpectedRules: []string{"Wired authentication a1", "Wired authorization r1", "Wired authorization r2", "Wired authorization r3", " global_exception g1"},
+			ExpectedSets:  []string{"Wired 4 60", "Default 0 0"},
+		},
+		"unused rules": {
+			Filter:        policyHitCountFilter{maxHits: &zero},
+			ExpectedRules: []string{"Wired authorization r2", " global_exception g1"},
+			ExpectedSets:  []string{"Wired 4 60", "Default 0 0"},
+		},
+		"policy set": {
+			Filter:        policyHitCountFilter{policySetName: "Wired", minHits: &five},
+			ExpectedRules: []string{"Wired authentication a1", "Wired authorization r1", "Wired authorization r3"},
+			ExpectedSets:  []string{"Wired 4 60"},
+		},
+	}
+	for tn, tc := range cases {
+		sets, rules, err := networkAccessPolicySnapshotDomain(client).hitCounts(tc.Filter)
+		if err != nil {
+			t.Fatalf("bad: %s, unexpected error: %s", tn, err)
+		}
+		actualRules := []string{}
+		for _, rule := range rules {
+			actualRules = append(actualRules, rule.policySetName+" "+rule.ruleType+" "+conditionString(rule.rule, "id"))
+		}
+		if !reflect.DeepEqual(actualRules, tc.ExpectedRules) {
+			t.Errorf("bad: %s, expected rules %v, got %v", tn, tc.ExpectedRules, actualRules)
+		}
+		actualSets := []string{}
+		for _, set := range flattenPolicyHitCountSets(sets) {
+			actualSets = append(actualSets, interfaceToString(set["name"])+" "+interfaceToString(set["rules"])+" "+interfaceToString(set["rule_hit_counts"]))
+		}
+		if !reflect.DeepEqual(actualSets, tc.ExpectedSets) {
+			t.Errorf("bad: %s, expected policy sets %v, got %v", tn, tc.ExpectedSets, actualSets)
+		}
+	}
+}
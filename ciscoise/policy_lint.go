@@ -0,0 +1,217 @@
+package ciscoise
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// policyLintFinding is an issue found in the rules of a policy set.
+type policyLintFinding struct {
+	rule        map[string]interface{}
+	findingType string
+	severity    string
+	related     map[string]interface{}
+	message     string
+}
+
+// lintPolicyRules analyses rules, the rule objects of the rules of a policy
+// set, and returns the findings in rank order. now dates the time and date
+// conditions whose range has ended.
+func lintPolicyRules(rules []map[string]interface{}, conditions []map[string]interface{}, now time.Time) []policyLintFinding {
+	library := newPolicyEvaluator(nil, conditions).conditions
+	rules = sortPolicyObjects(rules)
+	findings := []policyLintFinding{}
+	inlined := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		missing, ended := []string{}, []string{}
+		inlined[i] = inlineConditionReferences(library, rule["condition"], map[string]bool{}, &missing, &ended, now)
+		for _, reference := range missing {
+			findings = append(findings, policyLintFinding{
+				rule: rule, findingType: "missing_condition", severity: "error",
+				message: fmt.Sprintf("library condition %s does not exist", reference),
+			})
+		}
+		for _, reference := range ended {
+			findings = append(findings, policyLintFinding{
+				rule: rule, findingType: "disabled_condition", severity: "warning",
+				message: fmt.Sprintf("time and date condition %s ended, the rule can no longer match", reference),
+			})
+		}
+		if hitCounts, ok := rule["hitCounts"].(float64); ok && hitCounts == 0 && isPolicyObjectActive(rule) {
+			findings = append(findings, policyLintFinding{
+				rule: rule, findingType: "unused", severity: "info",
+				message: "the rule was never matched",
+			})
+		}
+	}
+	for j, rule := range rules {
+		if rule["default"] == true || !isPolicyObjectActive(rule) {
+			continue
+		}
+		for i := 0; i < j; i++ {
+			earlier := rules[i]
+			if earlier["default"] == true || !isPolicyObjectApplied(earlier) {
+				continue
+			}
+			if reflect.DeepEqual(inlined[i], inlined[j]) {
+				findings = append(findings, policyLintFinding{
+					rule: rule, findingType: "duplicate_condition", severity: "warning", related: earlier,
+					message: fmt.Sprintf("the condition is the same as the one of rule %s, which is evaluated first", conditionString(earlier, "name")),
+				})
+				break
+			}
+			if conditionImplies(inlined[j], inlined[i]) {
+				findings = append(findings, policyLintFinding{
+					rule: rule, findingType: "shadowed", severity: "warning", related: earlier,
+					message: fmt.Sprintf("rule %s matches every request this rule matches and is evaluated first", conditionString(earlier, "name")),
+				})
+				break
+			}
+		}
+	}
+	ranks := map[string]int{}
+	for i, rule := range rules {
+		ranks[conditionString(rule, "id")] = i
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return ranks[conditionString(findings[i].rule, "id")] < ranks[conditionString(findings[j].rule, "id")]
+	})
+	return findings
+}
+
+func isPolicyObjectActive(object map[string]interface{}) bool {
+	return conditionString(object, "state") != "disabled"
+}
+
+// inlineConditionReferences returns the normalized condition tree of v with
+// the references to library conditions replaced by their tree, so equivalent
+// conditions compare equal. The references that do not exist are kept and
+// added to missing, the time and date conditions whose range ended before
+// now are added to ended.
+func inlineConditionReferences(library map[string]map[string]interface{}, v interface{}, visiting map[string]bool, missing *[]string, ended *[]string, now time.Time) interface{} {
+	condition, ok := normalizeConditionJSON(v).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	result := map[string]interface{}{}
+	for key, value := range condition {
+		switch key {
+		case "id", "name", "description":
+		case "conditionType":
+			result[key] = strings.TrimPrefix(interfaceToString(value), "Library")
+		case "children":
+			children := []interface{}{}
+			for _, child := range value.([]interface{}) {
+				children = append(children, inlineConditionReferences(library, child, visiting, missing, ended, now))
+			}
+			result[key] = children
+		default:
+			result[key] = value
+		}
+	}
+	switch result["conditionType"] {
+	case "ConditionReference":
+		reference := conditionString(condition, "id")
+		if reference == "" {
+			reference = conditionString(condition, "name")
+		}
+		target, ok := library[reference]
+		if !ok || visiting[reference] {
+			if !ok {
+				*missing = append(*missing, reference)
+			}
+			return condition
+		}
+		visiting[reference] = true
+		defer delete(visiting, reference)
+		inlined, _ := inlineConditionReferences(library, target, visiting, missing, ended, now).(map[string]interface{})
+		if inlined == nil {
+			return nil
+		}
+		if condition["isNegate"] == true {
+			if inlined["isNegate"] == true {
+				delete(inlined, "isNegate")
+			} else {
+				inlined["isNegate"] = true
+			}
+		}
+		return inlined
+	case "TimeAndDateCondition":
+		endDate := interfaceToString(getJSONPath(condition, []string{"datesRange", "endDate"}))
+		if _, err := time.Parse("2006-01-02", endDate); err == nil && endDate < now.Format("2006-01-02") {
+			name := conditionString(condition, "name")
+			if name == "" {
+				name = endDate
+			}
+			*ended = append(*ended, name)
+		}
+	}
+	return result
+}
+
+// conditionImplies reports whether every request matching condition a also
+// matches condition b, for normalized trees with the references inlined. It
+// is conservative: false means the implication could not be proven.
+func conditionImplies(a interface{}, b interface{}) bool {
+	if b == nil {
+		return true
+	}
+	if a == nil {
+		return false
+	}
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	ma, okA := a.(map[string]interface{})
+	mb, okB := b.(map[string]interface{})
+	if !okA || !okB {
+		return false
+	}
+	typeA, typeB := conditionString(ma, "conditionType"), conditionString(mb, "conditionType")
+	childrenA, _ := ma["children"].([]interface{})
+	childrenB, _ := mb["children"].([]interface{})
+	negateA, negateB := ma["isNegate"] == true, mb["isNegate"] == true
+	if typeA == "ConditionOrBlock" && !negateA && len(childrenA) > 0 {
+		for _, child := range childrenA {
+			if !conditionImplies(child, b) {
+				return false
+			}
+		}
+		return true
+	}
+	if typeB == "ConditionAndBlock" && !negateB && len(childrenB) > 0 {
+		for _, child := range childrenB {
+			if !conditionImplies(a, child) {
+				return false
+			}
+		}
+		return true
+	}
+	if typeA == "ConditionAndBlock" && !negateA {
+		for _, child := range childrenA {
+			if conditionImplies(child, b) {
+				return true
+			}
+		}
+	}
+	if typeB == "ConditionOrBlock" && !negateB {
+		for _, child := range childrenB {
+			if conditionImplies(a, child) {
+				return true
+			}
+		}
+	}
+	if typeA == "ConditionAttributes" && typeB == "ConditionAttributes" && !negateA && !negateB &&
+		conditionString(ma, "operator") == "equals" &&
+		conditionString(ma, "dictionaryName") == conditionString(mb, "dictionaryName") &&
+		conditionString(ma, "attributeName") == conditionString(mb, "attributeName") &&
+		conditionString(mb, "dictionaryValue") == "" {
+		// An attribute equal to a value matches b when the value does.
+		match, err := evaluateConditionOperator(conditionString(mb, "operator"), conditionString(ma, "attributeValue"), conditionString(mb, "attributeValue"))
+		return err == nil && match
+	}
+	return false
+}
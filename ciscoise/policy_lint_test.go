@@ -0,0 +1,97 @@
+package ciscoise
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConditionImplies(t *testing.T) {
+	cases := map[string]struct {
+		A        string
+		B        string
+		Expected bool
+	}{
+		"no condition": {
+			A:        `{"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"Service-Type","operator":"equals","attributeValue":"Framed"}`,
+			B:        `null`,
+			Expected: true,
+		},
+		"and block implies its children": {
+			A:        `{"conditionType":"ConditionAndBlock","children":[{"conditionType":"ConditionReference","id":"c1"},{"conditionType":"ConditionReference","id":"c2"}]}`,
+			B:        `{"conditionType":"ConditionReference","id":"c2"}`,
+			Expected: true,
+		},
+		"child does not imply the and block": {
+			A:        `{"conditionType":"ConditionReference","id":"c2"}`,
+			B:        `{"conditionType":"ConditionAndBlock","children":[{"conditionType":"ConditionReference","id":"c1"},{"conditionType":"ConditionReference","id":"c2"}]}`,
+			Expected: false,
+		},
+		"or block with fewer children": {
+			A:        `{"conditionType":"ConditionOrBlock","children":[{"conditionType":"ConditionReference","id":"c1"},{"conditionType":"ConditionReference","id":"c2"}]}`,
+			B:        `{"conditionType":"ConditionOrBlock","children":[{"conditionType":"ConditionReference","id":"c2"},{"conditionType":"ConditionReference","id":"c3"},{"conditionType":"ConditionReference","id":"c1"}]}`,
+			Expected: true,
+		},
+		"equals implies starts with": {
+			A:        `{"conditionType":"ConditionAttributes","dictionaryName":"IdentityGroup","attributeName":"Name","operator":"equals","attributeValue":"Endpoint Identity Groups:Profiled:Printers"}`,
+			B:        `{"conditionType":"ConditionAttributes","dictionaryName":"IdentityGroup","attributeName":"Name","operator":"startsWith","attributeValue":"Endpoint Identity Groups:Profiled"}`,
+			Expected: true,
+		},
+		"starts with does not imply equals": {
+			A:        `{"conditionType":"ConditionAttributes","dictionaryName":"IdentityGroup","attributeName":"Name","operator":"startsWith","attributeValue":"Endpoint Identity Groups:Profiled"}`,
+			B:        `{"conditionType":"ConditionAttributes","dictionaryName":"IdentityGroup","attributeName":"Name","operator":"equals","attributeValue":"Endpoint Identity Groups:Profiled:Printers"}`,
+			Expected: false,
+		},
+		"negated condition": {
+			A:        `{"conditionType":"ConditionReference","id":"c1","isNegate":true}`,
+			B:        `{"conditionType":"ConditionReference","id":"c1"}`,
+			Expected: false,
+		},
+	}
+	for tn, tc := range cases {
+		var a, b interface{}
+		_ = json.Unmarshal([]byte(tc.A), &a)
+		_ = json.Unmarshal([]byte(tc.B), &b)
+		if conditionImplies(a, b) != tc.Expected {
+			t.Errorf("bad: %s, expected conditionImplies to return %t", tn, tc.Expected)
+		}
+	}
+}
+
+func TestLintPolicyRules(t *testing.T) {
+	conditions := []map[string]interface{}{}
+	_ = json.Unmarshal([]byte(`[
+	  {"id":"c1","name":"Wired_802.1X","conditionType":"LibraryConditionAttributes","dictionaryName":"Radius","attributeName":"NAS-Port-Type","operator":"equals","attributeValue":"Ethernet"},
+	  {"id":"t1","name":"Summer_2025","conditionType":"TimeAndDateCondition","datesRange":{"startDate":"2025-06-01","endDate":"2025-08-31"}}
+	]`), &conditions)
+	entries := []map[string]interface{}{}
+	_ = json.Unmarshal([]byte(`[
+	  {"rule":{"id":"r1","name":"Wired","rank":0,"hitCounts":10,"condition":{"conditionType":"ConditionReference","id":"c1"}}},
+	  {"rule":{"id":"r2","name":"Wired printers","rank":1,"hitCounts":0,"condition":{"conditionType":"ConditionAndBlock","children":[
+	    {"conditionType":"ConditionReference","id":"c1"},
+	    {"conditionType":"ConditionAttributes","dictionaryName":"EndPoints","attributeName":"LogicalProfile","operator":"equals","attributeValue":"Printers"}
+	  ]}}},
+	  {"rule":{"id":"r3","name":"Wired again","rank":2,"hitCounts":3,"condition":{"conditionType":"ConditionAttributes","dictionaryName":"Radius","attributeName":"NAS-Port-Type","operator":"equals","attributeValue":"Ethernet"}}},
+	  {"rule":{"id":"r4","name":"Summer","rank":3,"hitCounts":7,"condition":{"conditionType":"ConditionReference","id":"t1"}}},
+	  {"rule":{"id":"r5","name":"Removed","rank":4,"state":"disabled","hitCounts":0,"condition":{"conditionType":"ConditionReference","id":"c9"}}},
+	  {"rule":{"id":"d","name":"Default","rank":5,"default":true,"hitCounts":0}}
+	]`), &entries)
+
+	findings := lintPolicyRules(policyRuleObjects(entries), conditions, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	actual := []string{}
+	for _, finding := range findings {
+		actual = append(actual, conditionString(finding.rule, "id")+" "+finding.findingType+" "+finding.severity+" "+conditionString(finding.related, "id"))
+	}
+	expected := []string{
+		"r2 unused info ",
+		"r2 shadowed warning r1",
+		"r3 duplicate_condition warning r1",
+		"r4 disabled_condition warning ",
+		"r5 missing_condition error ",
+		"d unused info ",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected findings %v, got %v", expected, actual)
+	}
+}
@@ -0,0 +1,300 @@
+package ciscoise
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/go-resty/resty/v2"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+// policySnapshotVolatileKeys are the keys that change without a change of
+// the policy, left out of the snapshots.
+var policySnapshotVolatileKeys = map[string]bool{
+	"link":      true,
+	"hitCounts": true,
+}
+
+// policySnapshotDomain reads the policy of a domain, network access or
+// device administration.
+type policySnapshotDomain struct {
+	name       string
+	conditions func() (*resty.Response, error)
+	policySets func() (*resty.Response, error)
+	// globalExceptionRules are the global exception rules, shared by the
+	// policy sets of the domain.
+	globalExceptionRules func() (*resty.Response, error)
+	// rules are the rules of a policy set, by the key of their list in the
+	// snapshot.
+	rules map[string]func(policyID string) (*resty.Response, error)
+}
+
+func networkAccessPolicySnapshotDomain(client *isegosdk.Client) policySnapshotDomain {
+	return policySnapshotDomain{
+		name: "network_access",
+		conditions: func() (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessConditions.GetNetworkAccessConditions()
+			return restyResp, err
+		},
+		policySets: func() (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySets()
+			return restyResp, err
+		},
+		globalExceptionRules: func() (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessAuthorizationGlobalExceptionRules.GetNetworkAccessPolicySetGlobalExceptionRules()
+			return restyResp, err
+		},
+		rules: map[string]func(policyID string) (*resty.Response, error){
+			"authentication_rules": func(policyID string) (*resty.Response, error) {
+				_, restyResp, err := client.NetworkAccessAuthenticationRules.GetNetworkAccessAuthenticationRules(policyID)
+				return restyResp, err
+			},
+			"authorization_rules": func(policyID string) (*resty.Response, error) {
+				_, restyResp, err := client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRules(policyID)
+				return restyResp, err
+			},
+			"local_exception_rules": func(policyID string) (*resty.Response, error) {
+				_, restyResp, err := client.NetworkAccessAuthorizationExceptionRules.GetNetworkAccessLocalExceptionRules(policyID)
+				return restyResp, err
+			},
+		},
+	}
+}
+
+func deviceAdministrationPolicySnapshotDomain(client *isegosdk.Client) policySnapshotDomain {
+	return policySnapshotDomain{
+		name: "device_administration",
+		conditions: func() (*resty.Response, error) {
+			_, restyResp, err := client.DeviceAdministrationConditions.GetDeviceAdminConditions()
+			return restyResp, err
+		},
+		policySets: func() (*resty.Response, error) {
+			_, restyResp, err := client.DeviceAdministrationPolicySet.GetDeviceAdminPolicySets()
+			return restyResp, err
+		},
+		globalExceptionRules: func() (*resty.Response, error) {
+			_, restyResp, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.GetDeviceAdminPolicySetGlobalExceptionRules()
+			return restyResp, err
+		},
+		rules: map[string]func(policyID string) (*resty.Response, error){
+			"authentication_rules": func(policyID string) (*resty.Response, error) {
+				_, restyResp, err := client.DeviceAdministrationAuthenticationRules.GetDeviceAdminAuthenticationRules(policyID)
+				return restyResp, err
+			},
+			"authorization_rules": func(policyID string) (*resty.Response, error) {
+				_, restyResp, err := client.DeviceAdministrationAuthorizationRules.GetDeviceAdminAuthorizationRules(policyID)
+				return restyResp, err
+			},
+			"local_exception_rules": func(policyID string) (*resty.Response, error) {
+				_, restyResp, err := client.DeviceAdministrationAuthorizationExceptionRules.GetDeviceAdminLocalExceptionRules(policyID)
+				return restyResp, err
+			},
+		},
+	}
+}
+
+// snapshot returns the canonical policy of the domain: the library
+// conditions by name, the global exception rules and the policy sets by
+// rank, each with its rules by rank.
+func (p policySnapshotDomain) snapshot() (map[string]interface{}, error) {
+	conditions, err := p.objects("library conditions", p.conditions)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(conditions, func(i, j int) bool {
+		return policySnapshotKey(conditions[i]) < policySnapshotKey(conditions[j])
+	})
+	globalExceptionRules, err := p.objects("global exception rules", p.globalExceptionRules)
+	if err != nil {
+		return nil, err
+	}
+	policySets, err := p.objects("policy sets", p.policySets)
+	if err != nil {
+		return nil, err
+	}
+	for _, policySet := range policySets {
+		policyID := conditionString(policySet, "id")
+		for key, rules := range p.rules {
+			entries, err := p.objects(fmt.Sprintf("%s of policy set %s", key, conditionString(policySet, "name")), func() (*resty.Response, error) {
+				return rules(policyID)
+			})
+			if err != nil {
+				return nil, err
+			}
+			policySet[key] = entries
+		}
+	}
+	return map[string]interface{}{
+		"conditions":             conditions,
+		"global_exception_rules": globalExceptionRules,
+		"policy_sets":            policySets,
+	}, nil
+}
+
+// objects returns the canonical objects of a list request, by rank.
+func (p policySnapshotDomain) objects(what string, list func() (*resty.Response, error)) ([]map[string]interface{}, error) {
+	restyResp, err := list()
+	objects, err := decodePolicyObjects(restyResp, err)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the %s %s: %v", p.name, what, err)
+	}
+	canonical := []map[string]interface{}{}
+	for _, object := range objects {
+		canonical = append(canonical, canonicalPolicySnapshotValue(object).(map[string]interface{}))
+	}
+	sort.SliceStable(canonical, func(i, j int) bool {
+		a, b := policySnapshotRankObject(canonical[i]), policySnapshotRankObject(canonical[j])
+		if (a["default"] == true) != (b["default"] == true) {
+			return b["default"] == true
+		}
+		rankI, _ := a["rank"].(float64)
+		rankJ, _ := b["rank"].(float64)
+		return rankI < rankJ
+	})
+	return canonical, nil
+}
+
+// policySnapshotRankObject returns the object holding the rank of a policy
+// set or rule, the rule object of policy rules.
+func policySnapshotRankObject(object map[string]interface{}) map[string]interface{} {
+	if rule, ok := object["rule"].(map[string]interface{}); ok {
+		return rule
+	}
+	return object
+}
+
+// policySnapshotKey returns the name identifying an element of a list of the
+// snapshot, empty when it has none.
+func policySnapshotKey(v interface{}) string {
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	return conditionString(policySnapshotRankObject(object), "name")
+}
+
+// canonicalPolicySnapshotValue returns v without the volatile keys.
+func canonicalPolicySnapshotValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		canonical := map[string]interface{}{}
+		for key, item := range value {
+			if !policySnapshotVolatileKeys[key] {
+				canonical[key] = canonicalPolicySnapshotValue(item)
+			}
+		}
+		return canonical
+	case []interface{}:
+		canonical := []interface{}{}
+		for _, item := range value {
+			canonical = append(canonical, canonicalPolicySnapshotValue(item))
+		}
+		return canonical
+	}
+	return v
+}
+
+// policySnapshotChange is an element added, removed or changed between two
+// snapshots.
+type policySnapshotChange struct {
+	path     string
+	change   string
+	oldValue interface{}
+	newValue interface{}
+}
+
+// diffPolicySnapshots returns the changes from one snapshot to another, by
+// path. The elements of lists are identified by name, or compared as a whole
+// when the list has elements without a name or with the same name.
+func diffPolicySnapshots(path string, from interface{}, to interface{}) []policySnapshotChange {
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+	fromObject, okFrom := from.(map[string]interface{})
+	toObject, okTo := to.(map[string]interface{})
+	if okFrom && okTo {
+		keys := []string{}
+		for key := range fromObject {
+			keys = append(keys, key)
+		}
+		for key := range toObject {
+			if _, ok := fromObject[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		changes := []policySnapshotChange{}
+		for _, key := range keys {
+			changes = append(changes, diffPolicySnapshotElements(joinPolicySnapshotPath(path, key), fromObject, toObject, key)...)
+		}
+		return changes
+	}
+	fromList, okFrom := policySnapshotKeyedList(from)
+	toList, okTo := policySnapshotKeyedList(to)
+	if okFrom && okTo {
+		keys := []string{}
+		for _, item := range from.([]interface{}) {
+			keys = append(keys, policySnapshotKey(item))
+		}
+		for _, item := range to.([]interface{}) {
+			if _, ok := fromList[policySnapshotKey(item)]; !ok {
+				keys = append(keys, policySnapshotKey(item))
+			}
+		}
+		changes := []policySnapshotChange{}
+		for _, key := range keys {
+			changes = append(changes, diffPolicySnapshotElements(fmt.Sprintf("%s[%q]", path, key), fromList, toList, key)...)
+		}
+		return changes
+	}
+	return []policySnapshotChange{{path: path, change: "changed", oldValue: from, newValue: to}}
+}
+
+func diffPolicySnapshotElements(path string, from map[string]interface{}, to map[string]interface{}, key string) []policySnapshotChange {
+	fromValue, okFrom := from[key]
+	toValue, okTo := to[key]
+	switch {
+	case !okFrom:
+		return []policySnapshotChange{{path: path, change: "added", newValue: toValue}}
+	case !okTo:
+		return []policySnapshotChange{{path: path, change: "removed", oldValue: fromValue}}
+	}
+	return diffPolicySnapshots(path, fromValue, toValue)
+}
+
+// policySnapshotKeyedList returns the elements of v by name, when v is a list
+// of elements with distinct names.
+func policySnapshotKeyedList(v interface{}) (map[string]interface{}, bool) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	keyed := map[string]interface{}{}
+	for _, item := range list {
+		key := policySnapshotKey(item)
+		if _, ok := keyed[key]; ok || key == "" {
+			return nil, false
+		}
+		keyed[key] = item
+	}
+	return keyed, true
+}
+
+func joinPolicySnapshotPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// policySnapshotJSON returns the canonical JSON document of a snapshot.
+func policySnapshotJSON(snapshot interface{}) (string, error) {
+	document, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(document), nil
+}
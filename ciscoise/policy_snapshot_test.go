@@ -0,0 +1,104 @@
+package ciscoise
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPolicySnapshotDomain(t *testing.T) {
+	responses := map[string]string{
+		"/api/v1/policy/network-access/condition": `{"response":[
+		  {"id":"c2","name":"Wireless","conditionType":"LibraryConditionAttributes","link":{"href":"https://ise/c2"}},
+		  {"id":"c1","name":"Wired","conditionType":"LibraryConditionAttributes","link":{"href":"https://ise/c1"}}
+		]}`,
+		"/api/v1/policy/network-access/policy-set": `{"response":[
+		  {"id":"d","name":"Default","rank":1,"default":true,"hitCounts":120},
+		  {"id":"p1","name":"Wired","rank":0,"hitCounts":7,"condition":{"conditionType":"ConditionReference","id":"c1","link":{"href":"https://ise/c1"}}}
+		]}`,
+		"/api/v1/policy/network-access/policy-set/global-exception": `{"response":[]}`,
+		"/api/v1/policy/network-access/policy-set/p1/authorization": `{"response":[
+		  {"rule":{"id":"r2","name":"Default","rank":1,"default":true,"hitCounts":3},"profile":["DenyAccess"]},
+		  {"rule":{"id":"r1","name":"Printers","rank":0,"hitCounts":4},"profile":["PermitAccess"]}
+		]}`,
+	}
+	client := newTestClientConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			response = `{"response":[]}`
+		}
+		_, _ = w.Write([]byte(response))
+	}).Client
+
+	snapshot, err := networkAccessPolicySnapshotDomain(client).snapshot()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	document, err := policySnapshotJSON(snapshot)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var actual interface{}
+	_ = json.Unmarshal([]byte(document), &actual)
+	var expected interface{}
+	_ = json.Unmarshal([]byte(`{
+	  "conditions":[
+	    {"id":"c1","name":"Wired","conditionType":"LibraryConditionAttributes"},
+	    {"id":"c2","name":"Wireless","conditionType":"LibraryConditionAttributes"}
+	  ],
+	  "global_exception_rules":[],
+	  "policy_sets":[
+	    {"id":"p1","name":"Wired","rank":0,"condition":{"conditionType":"ConditionReference","id":"c1"},
+	     "authentication_rules":[],"local_exception_rules":[],
+	     "authorization_rules":[
+	       {"rule":{"id":"r1","name":"Printers","rank":0},"profile":["PermitAccess"]},
+	       {"rule":{"id":"r2","name":"Default","rank":1,"default":true},"profile":["DenyAccess"]}
+	     ]},
+	    {"id":"d","name":"Default","rank":1,"default":true,
+	     "authentication_rules":[],"authorization_rules":[],"local_exception_rules":[]}
+	  ]
+	}`), &expected)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected snapshot %s", document)
+	}
+	again, _ := policySnapshotJSON(snapshot)
+	if again != document {
+		t.Errorf("expected the snapshot document to be stable")
+	}
+}
+
+func TestDiffPolicySnapshots(t *testing.T) {
+	oldJSON := `{"network_access":{"policy_sets":[
+	  {"name":"Wired","rank":0,"authorization_rules":[
+	    {"rule":{"name":"Printers","rank":0},"profile":["PermitAccess"]},
+	    {"rule":{"name":"Cameras","rank":1},"profile":["PermitAccess"]}
+	  ]}
+	]}}`
+	newJSON := `{"network_access":{"policy_sets":[
+	  {"name":"Wired","rank":0,"description":"Wired access","authorization_rules":[
+	    {"rule":{"name":"Printers","rank":0},"profile":["PermitAccess","Printers"]},
+	    {"rule":{"name":"Phones","rank":1},"profile":["PermitAccess"]}
+	  ]}
+	]}}`
+	var oldSnapshot, newSnapshot interface{}
+	_ = json.Unmarshal([]byte(oldJSON), &oldSnapshot)
+	_ = json.Unmarshal([]byte(newJSON), &newSnapshot)
+	actual := []string{}
+	for _, change := range diffPolicySnapshots("", oldSnapshot, newSnapshot) {
+		actual = append(actual, change.change+" "+change.path)
+	}
+	expected := []string{
+		`changed network_access.policy_sets["Wired"].authorization_rules["Printers"].profile`,
+		`removed network_access.policy_sets["Wired"].authorization_rules["Cameras"]`,
+		`added network_access.policy_sets["Wired"].authorization_rules["Phones"]`,
+		`added network_access.policy_sets["Wired"].description`,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected changes %v, got %v", expected, actual)
+	}
+	if changes := diffPolicySnapshots("", oldSnapshot, oldSnapshot); len(changes) != 0 {
+		t.Errorf("expected no changes between identical snapshots, got %v", changes)
+	}
+}
@@ -20,6 +20,14 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ISE_BASE_URL", nil),
 				Description: "Identity Services Engine base URL, FQDN or IP. If not set, it uses the ISE_BASE_URL environment variable.",
 			},
+			"fallback_base_urls": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Ordered list of secondary PAN base URLs used when `base_url` does not answer or fails with a 5xx status. POST and PATCH requests only fail over when the connection to `base_url` could not be opened. The node that answers stays active for the next requests. If not set, it uses the comma-separated ISE_FALLBACK_BASE_URLS environment variable.",
+			},
 			"username": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -49,6 +57,31 @@ func Provider() *schema.Provider {
 				ValidateFunc: validateStringHasValueFunc([]string{"true", "false"}),
 				Description:  "Flag to enable or disable SSL certificate verification. If not set, it uses the ISE_SSL_VERIFY environment variable; defaults to `true`.",
 			},
+			"ca_certificate": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ISE_CA_CERTIFICATE", nil),
+				Description: "PEM encoded CA certificate, or the path of a file containing it, used to verify the Identity Services Engine certificate in addition to the system trust store. If not set, it uses the ISE_CA_CERTIFICATE environment variable.",
+			},
+			"client_certificate": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ISE_CLIENT_CERTIFICATE", nil),
+				Description: "PEM encoded client certificate, or the path of a file containing it, used for mutual TLS. It requires `client_key`. If not set, it uses the ISE_CLIENT_CERTIFICATE environment variable.",
+			},
+			"client_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ISE_CLIENT_KEY", nil),
+				Description: "PEM encoded private key of `client_certificate`, or the path of a file containing it. If not set, it uses the ISE_CLIENT_KEY environment variable.",
+			},
+			"tls_server_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ISE_TLS_SERVER_NAME", nil),
+				Description: "Server name used to verify the Identity Services Engine certificate, when it differs from the host of `base_url`. If not set, it uses the ISE_TLS_SERVER_NAME environment variable.",
+			},
 			"use_api_gateway": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -70,6 +103,34 @@ func Provider() *schema.Provider {
 				ValidateFunc: validateIntegerGeqThan(0),
 				Description:  "Timeout (in seconds) for the RESTful HTTP requests. If not set, it uses the ISE_SINGLE_REQUEST_TIMEOUT environment varible; defaults to 60.",
 			},
+			"max_retries": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ISE_MAX_RETRIES", 3),
+				ValidateFunc: validateIntegerGeqThan(0),
+				Description:  "Maximum number of retries for RESTful HTTP requests that fail with a transient error (429, 502, 503, 504 or a connection error on idempotent requests). If not set, it uses the ISE_MAX_RETRIES environment variable; defaults to 3. Use 0 to disable retries.",
+			},
+			"retry_min_wait": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ISE_RETRY_MIN_WAIT", 1),
+				ValidateFunc: validateIntegerGeqThan(1),
+				Description:  "Minimum time (in seconds) to wait before retrying a request, at least 1. If not set, it uses the ISE_RETRY_MIN_WAIT environment variable; defaults to 1.",
+			},
+			"retry_max_wait": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ISE_RETRY_MAX_WAIT", 30),
+				ValidateFunc: validateIntegerGeqThan(0),
+				Description:  "Maximum time (in seconds) to wait before retrying a request, it also caps the wait requested by a `Retry-After` header. If not set, it uses the ISE_RETRY_MAX_WAIT environment variable; defaults to 30.",
+			},
+			"max_concurrent_requests": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ISE_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validateIntegerGeqThan(0),
+				Description:  "Maximum number of RESTful HTTP requests sent to Identity Services Engine at the same time, regardless of the Terraform parallelism. ISE limits the concurrent ERS admin sessions. If not set, it uses the ISE_MAX_CONCURRENT_REQUESTS environment variable; defaults to 0 (no limit).",
+			},
 			"enable_auto_import": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -110,7 +171,7 @@ func Provider() *schema.Provider {
 			"ciscoise_network_access_authorization_rules_reset_hitcount":           resourceNetworkAccessAuthorizationRulesResetHitcount(),
 			"ciscoise_network_access_authentication_rules_reset_hitcount":          resourceNetworkAccessAuthenticationRulesResetHitcount(),
 			"ciscoise_sxp_vpns_bulk_request":                                       resourceSxpVpnsBulkRequest(),
-			"ciscoise_sxp_local_bindings_bulk_request":                             resourceSxpConnectionsBulkRequest(),
+			"ciscoise_sxp_local_bindings_bulk_request":                             resourceSxpLocalBindingsBulkRequest(),
 			"ciscoise_sxp_connections_bulk_request":                                resourceSxpConnectionsBulkRequest(),
 			"ciscoise_network_device_bulk_request":                                 resourceNetworkDeviceBulkRequest(),
 			"ciscoise_guest_user_bulk_request":                                     resourceGuestUserBulkRequest(),
@@ -168,6 +229,7 @@ func Provider() *schema.Provider {
 			"ciscoise_device_administration_policy_set":                            resourceDeviceAdministrationPolicySet(),
 			"ciscoise_device_administration_authentication_rules":                  resourceDeviceAdministrationAuthenticationRules(),
 			"ciscoise_device_administration_authorization_rules":                   resourceDeviceAdministrationAuthorizationRules(),
+			"ciscoise_device_administration_authorization_rule_list":               resourceDeviceAdministrationAuthorizationRuleList(),
 			"ciscoise_device_administration_authorization_rules_update":            resourceDeviceAdministrationAuthorizationRulesUpdateUpdate(),
 			"ciscoise_device_administration_local_exception_rules":                 resourceDeviceAdministrationLocalExceptionRules(),
 			"ciscoise_device_administration_global_exception_rules":                resourceDeviceAdministrationGlobalExceptionRules(),
@@ -182,6 +244,7 @@ func Provider() *schema.Provider {
 			"ciscoise_byod_portal":                                                 resourceByodPortal(),
 			"ciscoise_certificate_profile":                                         resourceCertificateProfile(),
 			"ciscoise_downloadable_acl":                                            resourceDownloadableACL(),
+			"ciscoise_egress_matrix":                                               resourceEgressMatrix(),
 			"ciscoise_egress_matrix_cell":                                          resourceEgressMatrixCell(),
 			"ciscoise_endpoint":                                                    resourceEndpoint(),
 			"ciscoise_endpoint_group":                                              resourceEndpointGroup(),
@@ -226,9 +289,11 @@ func Provider() *schema.Provider {
 			"ciscoise_network_access_dictionary":                                   resourceNetworkAccessDictionary(),
 			"ciscoise_network_access_dictionary_attribute":                         resourceNetworkAccessDictionaryAttribute(),
 			"ciscoise_network_access_network_condition":                            resourceNetworkAccessNetworkCondition(),
+			"ciscoise_network_access_policy_bundle":                                resourceNetworkAccessPolicyBundle(),
 			"ciscoise_network_access_policy_set":                                   resourceNetworkAccessPolicySet(),
 			"ciscoise_network_access_authentication_rules":                         resourceNetworkAccessAuthenticationRules(),
 			"ciscoise_network_access_authorization_rules":                          resourceNetworkAccessAuthorizationRules(),
+			"ciscoise_network_access_authorization_rule_list":                      resourceNetworkAccessAuthorizationRuleList(),
 			"ciscoise_network_access_authorization_rules_update":                   resourceNetworkAccessAuthorizationRulesUpdateUpdate(),
 			"ciscoise_network_access_local_exception_rules":                        resourceNetworkAccessLocalExceptionRules(),
 			"ciscoise_network_access_global_exception_rules":                       resourceNetworkAccessGlobalExceptionRules(),
@@ -300,6 +365,7 @@ func Provider() *schema.Provider {
 			"ciscoise_downloadable_acl":                                           dataSourceDownloadableACL(),
 			"ciscoise_egress_matrix_cell":                                         dataSourceEgressMatrixCell(),
 			"ciscoise_egress_matrix_cell_bulk_monitor_status":                     dataSourceEgressMatrixCellBulkMonitorStatus(),
+			"ciscoise_egress_matrix_csv":                                          dataSourceEgressMatrixCSV(),
 			"ciscoise_endpoint":                                                   dataSourceEndpoint(),
 			"ciscoise_endpoint_bulk_monitor_status":                               dataSourceEndpointBulkMonitorStatus(),
 			"ciscoise_endpoint_get_rejected_endpoints":                            dataSourceEndpointGetRejectedEndpoints(),
@@ -323,6 +389,9 @@ func Provider() *schema.Provider {
 			"ciscoise_node":                                                       dataSourceNode(),
 			"ciscoise_native_supplicant_profile":                                  dataSourceNativeSupplicantProfile(),
 			"ciscoise_system_config_version":                                      dataSourceSystemConfigVersion(),
+			"ciscoise_policy_hitcount_report":                                     dataSourcePolicyHitcountReport(),
+			"ciscoise_policy_snapshot":                                            dataSourcePolicySnapshot(),
+			"ciscoise_policy_snapshot_diff":                                       dataSourcePolicySnapshotDiff(),
 			"ciscoise_portal":                                                     dataSourcePortal(),
 			"ciscoise_portal_global_setting":                                      dataSourcePortalGlobalSetting(),
 			"ciscoise_portal_theme":                                               dataSourcePortalTheme(),
@@ -393,6 +462,8 @@ func Provider() *schema.Provider {
 			"ciscoise_network_access_dictionary_attributes_policy_set":            dataSourceNetworkAccessDictionaryAttributesPolicySet(),
 			"ciscoise_network_access_identity_stores":                             dataSourceNetworkAccessIDentityStores(),
 			"ciscoise_network_access_network_condition":                           dataSourceNetworkAccessNetworkCondition(),
+			"ciscoise_network_access_policy_evaluation":                           dataSourceNetworkAccessPolicyEvaluation(),
+			"ciscoise_network_access_policy_lint":                                 dataSourceNetworkAccessPolicyLint(),
 			"ciscoise_network_access_policy_set":                                  dataSourceNetworkAccessPolicySet(),
 			"ciscoise_network_access_authentication_rules":                        dataSourceNetworkAccessAuthenticationRules(),
 			"ciscoise_network_access_authorization_rules":                         dataSourceNetworkAccessAuthorizationRules(),
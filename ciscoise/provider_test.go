@@ -23,6 +23,50 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// secretAttributes are the schema attribute names that hold credentials.
+var secretAttributes = map[string]bool{
+	"password":                       true,
+	"pass_word":                      true,
+	"admin_password":                 true,
+	"acipassword":                    true,
+	"primary_password":               true,
+	"enable_password":                true,
+	"enable_mode_password":           true,
+	"exec_mode_password":             true,
+	"sga_device_password":            true,
+	"shared_secret":                  true,
+	"radius_shared_secret":           true,
+	"second_radius_shared_secret":    true,
+	"key_encryption_key":             true,
+	"message_authenticator_code_key": true,
+	"authenticator_key":              true,
+	"encryption_key":                 true,
+	"backup_encryption_key":          true,
+	"private_key_data":               true,
+	"ro_community":                   true,
+}
+
+func checkSensitiveAttributes(t *testing.T, path string, attributes map[string]*schema.Schema) {
+	for name, attribute := range attributes {
+		if secretAttributes[name] && !attribute.Sensitive {
+			t.Errorf("Attribute %s.%s holds a secret and must be Sensitive", path, name)
+		}
+		if elem, ok := attribute.Elem.(*schema.Resource); ok {
+			checkSensitiveAttributes(t, path+"."+name, elem.Schema)
+		}
+	}
+}
+
+func TestProviderSensitiveAttributes(t *testing.T) {
+	provider := Provider()
+	for name, resource := range provider.ResourcesMap {
+		checkSensitiveAttributes(t, name, resource.Schema)
+	}
+	for name, dataSource := range provider.DataSourcesMap {
+		checkSensitiveAttributes(t, name, dataSource.Schema)
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
@@ -0,0 +1,43 @@
+package ciscoise
+
+import (
+	"regexp"
+	"strings"
+)
+
+const redactedValue = "******"
+
+// secretKeys are the JSON keys of the ISE API whose values must never be
+// written to the logs.
+var secretKeys = []string{
+	"password",
+	"passWord",
+	"adminPassword",
+	"acipassword",
+	"enablePassword",
+	"enableModePassword",
+	"execModePassword",
+	"sgaDevicePassword",
+	"sharedSecret",
+	"radiusSharedSecret",
+	"secondRadiusSharedSecret",
+	"keyEncryptionKey",
+	"messageAuthenticatorCodeKey",
+	"authenticatorKey",
+	"encryptionKey",
+	"backupEncryptionKey",
+	"privateKeyData",
+	"roCommunity",
+}
+
+var (
+	secretValueRegexp  = regexp.MustCompile(`(?i)("(?:` + strings.Join(secretKeys, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)+"`)
+	secretHeaderRegexp = regexp.MustCompile(`(?im)^(\s*(?:Authorization|Cookie|Set-Cookie|X-CSRF-Token)\s*:\s*)\S.*$`)
+)
+
+// redactSecrets masks the values of the known secret JSON keys and the
+// authentication headers in text that is about to be logged.
+func redactSecrets(text string) string {
+	text = secretValueRegexp.ReplaceAllString(text, `${1}"`+redactedValue+`"`)
+	return secretHeaderRegexp.ReplaceAllString(text, `${1}`+redactedValue)
+}
@@ -73,6 +73,7 @@ func resourceAciSettings() *schema.Resource {
 						"admin_password": &schema.Schema{
 							Description: `ACI Cluster Admin password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 						},
 						"all_sxp_domain": &schema.Schema{
@@ -197,6 +198,7 @@ func resourceAciSettings() *schema.Resource {
 						"admin_password": &schema.Schema{
 							Description: `ACI Cluster Admin password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Computed:    true,
 						},
@@ -317,7 +319,7 @@ func resourceAciSettingsCreate(ctx context.Context, d *schema.ResourceData, m in
 	response1, restyResp1, err := client.AciSettings.UpdateAciSettingsByID(vvID, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateAciSettingsByID", err, restyResp1.String(),
 				"Failure at UpdateAciSettingsByID, unexpected response", ""))
@@ -349,10 +351,9 @@ func resourceAciSettingsRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAciSettings", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -396,7 +397,7 @@ func resourceAciSettingsUpdate(ctx context.Context, d *schema.ResourceData, m in
 		response1, restyResp1, err := client.AciSettings.UpdateAciSettingsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateAciSettingsByID", err, restyResp1.String(),
 					"Failure at UpdateAciSettingsByID, unexpected response", ""))
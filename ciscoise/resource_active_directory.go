@@ -29,7 +29,7 @@ func resourceActiveDirectory() *schema.Resource {
 		UpdateContext: resourceActiveDirectoryUpdate,
 		DeleteContext: resourceActiveDirectoryDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceActiveDirectoryRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -833,10 +833,9 @@ func resourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetActiveDirectoryByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -865,10 +864,9 @@ func resourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetActiveDirectoryByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -943,7 +941,7 @@ func resourceActiveDirectoryUpdate(ctx context.Context, d *schema.ResourceData,
 
 						if err != nil || response1 == nil {
 							if response1 != nil {
-								log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+								log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 								diags = append(diags, diagErrorWithAltAndResponse(
 									"Failure when executing LoadGroupsFromDomain", err, response1.String(),
 									"Failure at LoadGroupsFromDomain, unexpected response", ""))
@@ -955,7 +953,7 @@ func resourceActiveDirectoryUpdate(ctx context.Context, d *schema.ResourceData,
 							return diags
 						}
 
-						log.Printf("[DEBUG] Retrieved response %s", response1.String())
+						log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 						_ = d.Set("last_updated", getUnixTimeString())
 					}
 				}
@@ -1010,7 +1008,7 @@ func resourceActiveDirectoryDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.ActiveDirectory.DeleteActiveDirectoryByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteActiveDirectoryByID", err, restyResp1.String(),
 				"Failure at DeleteActiveDirectoryByID, unexpected response", ""))
@@ -444,7 +444,7 @@ func resourceActiveDirectoryAddGroupsCreate(ctx context.Context, d *schema.Resou
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
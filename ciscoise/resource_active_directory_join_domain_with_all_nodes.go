@@ -90,7 +90,7 @@ func resourceActiveDirectoryJoinDomainWithAllNodesCreate(ctx context.Context, d
 	response1, err := client.ActiveDirectory.JoinDomainWithAllNodes(vvID, request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing JoinDomainWithAllNodes", err, response1.String(),
 				"Failure at JoinDomainWithAllNodes, unexpected response", ""))
@@ -101,7 +101,7 @@ func resourceActiveDirectoryJoinDomainWithAllNodesCreate(ctx context.Context, d
 			"Failure at JoinDomainWithAllNodes, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
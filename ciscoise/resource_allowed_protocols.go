@@ -28,7 +28,7 @@ func resourceAllowedProtocols() *schema.Resource {
 		UpdateContext: resourceAllowedProtocolsUpdate,
 		DeleteContext: resourceAllowedProtocolsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceAllowedProtocolsRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1308,10 +1308,9 @@ func resourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAllowedProtocolByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1340,10 +1339,9 @@ func resourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAllowedProtocolByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1413,7 +1411,7 @@ func resourceAllowedProtocolsUpdate(ctx context.Context, d *schema.ResourceData,
 		response1, restyResp1, err := client.AllowedProtocols.UpdateAllowedProtocolByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateAllowedProtocolByID", err, restyResp1.String(),
 					"Failure at UpdateAllowedProtocolByID, unexpected response", ""))
@@ -1473,7 +1471,7 @@ func resourceAllowedProtocolsDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.AllowedProtocols.DeleteAllowedProtocolByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteAllowedProtocolByID", err, restyResp1.String(),
 				"Failure at DeleteAllowedProtocolByID, unexpected response", ""))
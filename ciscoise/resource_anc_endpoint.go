@@ -233,7 +233,7 @@ func resourceAncEndpointCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ApplyAncEndpoint", err, response1.String(),
 				"Failure at ApplyAncEndpoint, unexpected response", ""))
@@ -245,7 +245,7 @@ func resourceAncEndpointCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 	resourceMap := make(map[string]string)
 	resourceMap["id"] = vvID
@@ -276,10 +276,9 @@ func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m inte
 		response1, restyResp1, err := client.AncEndpoint.GetAncEndpoint(&queryParams1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAncEndpoint", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -287,8 +286,7 @@ func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m inte
 		items1 := getAllItemsAncEndpointGetAncEndpoint(m, response1, &queryParams1)
 		item1, err := searchAncEndpointGetAncEndpoint(m, items1, vvPolicyName, vvMacAddress, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetAncEndpoint", err, nil)
 		}
 
 		vItem1 := flattenAncEndpointGetAncEndpointByIDItem(item1)
@@ -313,10 +311,9 @@ func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAncEndpointByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -418,7 +415,7 @@ func resourceAncEndpointDelete(ctx context.Context, d *schema.ResourceData, m in
 
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ClearAncEndpoint", err, response1.String(),
 				"Failure at ClearAncEndpoint, unexpected response", ""))
@@ -430,7 +427,7 @@ func resourceAncEndpointDelete(ctx context.Context, d *schema.ResourceData, m in
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
 	// it is added here for explicitness.
@@ -2,12 +2,12 @@ package ciscoise
 
 import (
 	"context"
-	"reflect"
-
 	"log"
+	"reflect"
 
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -16,74 +16,38 @@ func resourceAncEndpointBulkRequest() *schema.Resource {
 	return &schema.Resource{
 		Description: `It performs update operation on ANCEndpoint.
 - This resource allows the client to submit the bulk request.
+- It waits until the bulk request finishes and stores the status of each resource in item.
 `,
 
 		CreateContext: resourceAncEndpointBulkRequestCreate,
 		ReadContext:   resourceAncEndpointBulkRequestRead,
 		DeleteContext: resourceAncEndpointBulkRequestDelete,
 
-		Schema: map[string]*schema.Schema{
-			"last_updated": &schema.Schema{
-				Description: `Unix timestamp records the last time that the resource was updated.`,
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"item": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"parameters": &schema.Schema{
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
-				MinItems: 1,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"operation_type": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-						"resource_media_type": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-					},
-				},
-			},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(BULK_REQUEST_TIMEOUT),
 		},
+
+		SchemaVersion:  1,
+		StateUpgraders: resourceBulkRequestStateUpgraders(),
+
+		Schema: resourceBulkRequestSchema(resourceBulkRequestParametersElem(resourceAncEndpoint()), nil),
 	}
 }
 
 func resourceAncEndpointBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning BulkRequestForAncEndpoint create")
-	log.Printf("[DEBUG] Missing BulkRequestForAncEndpoint create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	var diags diag.Diagnostics
 	request1 := expandRequestAncEndpointBulkRequestBulkRequestForAncEndpoint(ctx, "parameters.0", d)
-	response1, err := client.AncEndpoint.BulkRequestForAncEndpoint(request1)
-	if err != nil || response1 == nil {
-		diags = append(diags, diagErrorWithAlt(
-			"Failure when executing BulkRequestForAncEndpoint", err,
-			"Failure at BulkRequestForAncEndpoint, unexpected response", ""))
-		return diags
-	}
-
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-	if err := d.Set("item", response1.String()); err != nil {
-		diags = append(diags, diagError(
-			"Failure when setting BulkRequestForAncEndpoint response",
-			err))
+	diags := executeBulkRequest(ctx, d, m, "BulkRequestForAncEndpoint", "/ers/config/ancendpoint/bulk/submit", "ErsAncEndpointBulkRequest", request1,
+		func(bulkID string) (interface{}, *resty.Response, error) {
+			return client.AncEndpoint.MonitorBulkStatusAncEndpoint(bulkID)
+		})
+	if diags.HasError() {
 		return diags
 	}
-	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-	d.SetId(getUnixTimeString())
-	return resourceAncEndpointBulkRequestRead(ctx, d, m)
+	return append(diags, resourceAncEndpointBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceAncEndpointBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -98,19 +62,32 @@ func resourceAncEndpointBulkRequestDelete(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
-func expandRequestAncEndpointBulkRequestBulkRequestForAncEndpoint(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestAncEndpointBulkRequestForAncEndpoint {
-	request := isegosdk.RequestAncEndpointBulkRequestForAncEndpoint{}
-	request.ErsAncEndpointBulkRequest = expandRequestAncEndpointBulkRequestBulkRequestForAncEndpointErsAncEndpointBulkRequest(ctx, key, d)
-	return &request
+func expandRequestAncEndpointBulkRequestBulkRequestForAncEndpoint(ctx context.Context, key string, d *schema.ResourceData) *ersBulkRequest {
+	return expandRequestBulkRequest(ctx, key, d,
+		func(ctx context.Context, key string, d *schema.ResourceData) interface{} {
+			return expandRequestAncEndpointBulkRequestResource(ctx, key, d)
+		},
+		nil,
+	)
 }
 
-func expandRequestAncEndpointBulkRequestBulkRequestForAncEndpointErsAncEndpointBulkRequest(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestAncEndpointBulkRequestForAncEndpointErsAncEndpointBulkRequest {
-	request := isegosdk.RequestAncEndpointBulkRequestForAncEndpointErsAncEndpointBulkRequest{}
-	if v, ok := d.GetOkExists(fixKeyAccess(key + ".operation_type")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".operation_type")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".operation_type")))) {
-		request.OperationType = interfaceToString(v)
+func expandRequestAncEndpointBulkRequestResource(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestAncEndpointApplyAncEndpoint {
+	additionalData := []isegosdk.RequestAncEndpointApplyAncEndpointOperationAdditionalDataAdditionalData{}
+	if v, ok := d.GetOkExists(fixKeyAccess(key + ".mac_address")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".mac_address")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".mac_address")))) {
+		additionalData = append(additionalData, isegosdk.RequestAncEndpointApplyAncEndpointOperationAdditionalDataAdditionalData{Name: "macAddress", Value: interfaceToString(v)})
+	}
+	if v, ok := d.GetOkExists(fixKeyAccess(key + ".ip_address")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".ip_address")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".ip_address")))) {
+		additionalData = append(additionalData, isegosdk.RequestAncEndpointApplyAncEndpointOperationAdditionalDataAdditionalData{Name: "ipAddress", Value: interfaceToString(v)})
+	}
+	if v, ok := d.GetOkExists(fixKeyAccess(key + ".policy_name")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".policy_name")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".policy_name")))) {
+		additionalData = append(additionalData, isegosdk.RequestAncEndpointApplyAncEndpointOperationAdditionalDataAdditionalData{Name: "policyName", Value: interfaceToString(v)})
+	}
+	if len(additionalData) == 0 {
+		return nil
 	}
-	if v, ok := d.GetOkExists(fixKeyAccess(key + ".resource_media_type")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".resource_media_type")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".resource_media_type")))) {
-		request.ResourceMediaType = interfaceToString(v)
+	request := isegosdk.RequestAncEndpointApplyAncEndpoint{}
+	request.OperationAdditionalData = &isegosdk.RequestAncEndpointApplyAncEndpointOperationAdditionalData{
+		AdditionalData: &additionalData,
 	}
 	return &request
 }
@@ -28,7 +28,7 @@ func resourceAncPolicy() *schema.Resource {
 		UpdateContext: resourceAncPolicyUpdate,
 		DeleteContext: resourceAncPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceAncPolicyRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -234,10 +234,9 @@ func resourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAncPolicyByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -266,10 +265,9 @@ func resourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAncPolicyByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -339,7 +337,7 @@ func resourceAncPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		response1, restyResp1, err := client.AncPolicy.UpdateAncPolicyByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateAncPolicyByID", err, restyResp1.String(),
 					"Failure at UpdateAncPolicyByID, unexpected response", ""))
@@ -399,7 +397,7 @@ func resourceAncPolicyDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.AncPolicy.DeleteAncPolicyByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteAncPolicyByID", err, restyResp1.String(),
 				"Failure at DeleteAncPolicyByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceAuthorizationProfile() *schema.Resource {
 		UpdateContext: resourceAuthorizationProfileUpdate,
 		DeleteContext: resourceAuthorizationProfileDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceAuthorizationProfileRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -785,10 +785,9 @@ func resourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAuthorizationProfileByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -817,10 +816,9 @@ func resourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetAuthorizationProfileByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -890,7 +888,7 @@ func resourceAuthorizationProfileUpdate(ctx context.Context, d *schema.ResourceD
 		response1, restyResp1, err := client.AuthorizationProfile.UpdateAuthorizationProfileByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateAuthorizationProfileByID", err, restyResp1.String(),
 					"Failure at UpdateAuthorizationProfileByID, unexpected response", ""))
@@ -950,7 +948,7 @@ func resourceAuthorizationProfileDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.AuthorizationProfile.DeleteAuthorizationProfileByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteAuthorizationProfileByID", err, restyResp1.String(),
 				"Failure at DeleteAuthorizationProfileByID, unexpected response", ""))
@@ -79,6 +79,7 @@ to get the status of the backup job
 						"backup_encryption_key": &schema.Schema{
 							Description: `The encryption key which was provided at the time of taking backup.`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							ForceNew:    true,
 						},
@@ -122,7 +123,7 @@ func resourceBackupRestoreCreate(ctx context.Context, d *schema.ResourceData, m
 	response1, restyResp1, err := client.BackupAndRestore.RestoreConfigBackup(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing RestoreConfigBackup", err, restyResp1.String(),
 				"Failure at RestoreConfigBackup, unexpected response", ""))
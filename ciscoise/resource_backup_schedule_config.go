@@ -81,6 +81,7 @@ creating the schedule for the first time.
 						"backup_encryption_key": &schema.Schema{
 							Description: `The encyption key for the backed up file. Encryption key must satisfy the following criteria - Contains at least one uppercase letter [A-Z], Contains at least one lowercase letter [a-z], Contains at least one digit [0-9], Contain only [A-Z][a-z][0-9]_#, Has at least 8 characters, Has not more than 15 characters, Must not contain 'CcIiSsCco', Must not begin with`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							ForceNew:    true,
 						},
@@ -157,7 +158,7 @@ func resourceBackupScheduleConfigCreate(ctx context.Context, d *schema.ResourceD
 	response1, restyResp1, err := client.BackupAndRestore.CreateScheduledConfigBackup(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing CreateScheduledConfigBackup", err, restyResp1.String(),
 				"Failure at CreateScheduledConfigBackup, unexpected response", ""))
@@ -199,7 +199,7 @@ func resourceBindSignedCertificateCreate(ctx context.Context, d *schema.Resource
 	response1, restyResp1, err := client.Certificates.BindCsr(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing BindCsr", err, restyResp1.String(),
 				"Failure at BindCsr, unexpected response", ""))
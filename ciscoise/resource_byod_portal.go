@@ -29,7 +29,7 @@ func resourceByodPortal() *schema.Resource {
 		UpdateContext: resourceByodPortalUpdate,
 		DeleteContext: resourceByodPortalDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceByodPortalRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1190,10 +1190,9 @@ func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m inter
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetByodPortal", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1201,8 +1200,7 @@ func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m inter
 		items1 := getAllItemsByodPortalGetByodPortal(m, response1, &queryParams1)
 		item1, err := searchByodPortalGetByodPortal(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetByodPortal", err, nil)
 		}
 		vItem1 := flattenByodPortalGetByodPortalByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -1233,10 +1231,9 @@ func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m inter
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetByodPortalByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1308,7 +1305,7 @@ func resourceByodPortalUpdate(ctx context.Context, d *schema.ResourceData, m int
 		response1, restyResp1, err := client.ByodPortal.UpdateByodPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateByodPortalByID", err, restyResp1.String(),
 					"Failure at UpdateByodPortalByID, unexpected response", ""))
@@ -1376,7 +1373,7 @@ func resourceByodPortalDelete(ctx context.Context, d *schema.ResourceData, m int
 	restyResp1, err := client.ByodPortal.DeleteByodPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteByodPortalByID", err, restyResp1.String(),
 				"Failure at DeleteByodPortalByID, unexpected response", ""))
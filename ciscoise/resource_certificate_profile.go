@@ -26,7 +26,7 @@ func resourceCertificateProfile() *schema.Resource {
 		UpdateContext: resourceCertificateProfileUpdate,
 		DeleteContext: resourceCertificateProfileDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceCertificateProfileRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -290,10 +290,9 @@ func resourceCertificateProfileRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetCertificateProfileByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -322,10 +321,9 @@ func resourceCertificateProfileRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetCertificateProfileByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -395,7 +393,7 @@ func resourceCertificateProfileUpdate(ctx context.Context, d *schema.ResourceDat
 		response1, restyResp1, err := client.CertificateProfile.UpdateCertificateProfileByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateCertificateProfileByID", err, restyResp1.String(),
 					"Failure at UpdateCertificateProfileByID, unexpected response", ""))
@@ -74,7 +74,7 @@ func resourceDeviceAdministrationAuthenticationResetHitcountCreate(ctx context.C
 	response1, restyResp1, err := client.DeviceAdministrationAuthenticationRules.ResetHitCountsDeviceAdminAuthenticationRules(vvPolicyID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminAuthenticationRules", err,
@@ -8,6 +8,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -43,7 +44,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceDeviceAdministrationAuthenticationRulesUpdate,
 		DeleteContext: resourceDeviceAdministrationAuthenticationRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateRuleByIDOrName(resourceDeviceAdministrationAuthenticationRulesRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -727,6 +728,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": resourceConditionExpressionSchema(),
+									"condition_name":       resourceConditionNameSchema(),
+									"condition_json":       resourceConditionJSONSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -796,6 +800,8 @@ func resourceDeviceAdministrationAuthenticationRulesCreate(ctx context.Context,
 
 	vPolicyID, okPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvID string
 	var vvName string
@@ -841,7 +847,20 @@ func resourceDeviceAdministrationAuthenticationRulesCreate(ctx context.Context,
 			}
 		}
 	}
-	resp1, restyResp1, err := client.DeviceAdministrationAuthenticationRules.CreateDeviceAdminAuthenticationRule(vvPolicyID, request1)
+	var resp1 *isegosdk.ResponseDeviceAdministrationAuthenticationRulesCreateDeviceAdminAuthenticationRule
+	var restyResp1 *resty.Response
+	body, ok, err := deviceAdminRuleConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateDeviceAdminAuthenticationRule", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseDeviceAdministrationAuthenticationRulesCreateDeviceAdminAuthenticationRule{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateDeviceAdminAuthenticationRule", resty.MethodPost, "/api/v1/policy/device-admin/policy-set/"+vvPolicyID+"/authentication", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.DeviceAdministrationAuthenticationRules.CreateDeviceAdminAuthenticationRule(vvPolicyID, request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -908,10 +927,9 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminAuthenticationRules", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -919,8 +937,7 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 		items1 := getAllItemsDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRules(m, response1, vvPolicyID)
 		item1, err := searchDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRules(m, items1, vvName, vvID, vvPolicyID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDeviceAdminAuthenticationRules", err, nil)
 		}
 		vItem1 := flattenDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRuleByIDItem(item1, vvPolicyID)
 		if err := d.Set("item", vItem1); err != nil {
@@ -929,6 +946,9 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
+		if item1.Rule != nil {
+			deviceAdminRuleConditionJSON.flatten(d, vItem1, restyResp1, item1.Rule.ID)
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminAuthenticationRules search response",
@@ -943,10 +963,9 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminAuthenticationRuleByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -958,6 +977,7 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
+		deviceAdminRuleConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminAuthenticationRuleByID response",
@@ -998,6 +1018,8 @@ func resourceDeviceAdministrationAuthenticationRulesUpdate(ctx context.Context,
 	vvID := vID
 	vvName := vName
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
@@ -1029,10 +1051,23 @@ func resourceDeviceAdministrationAuthenticationRulesUpdate(ctx context.Context,
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.DeviceAdministrationAuthenticationRules.UpdateDeviceAdminAuthenticationRuleByID(vvPolicyID, vvID, request1)
+		var response1 *isegosdk.ResponseDeviceAdministrationAuthenticationRulesUpdateDeviceAdminAuthenticationRuleByID
+		var restyResp1 *resty.Response
+		body, ok, err := deviceAdminRuleConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateDeviceAdminAuthenticationRuleByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseDeviceAdministrationAuthenticationRulesUpdateDeviceAdminAuthenticationRuleByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateDeviceAdminAuthenticationRuleByID", resty.MethodPut, "/api/v1/policy/device-admin/policy-set/"+vvPolicyID+"/authentication/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.DeviceAdministrationAuthenticationRules.UpdateDeviceAdminAuthenticationRuleByID(vvPolicyID, vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminAuthenticationRuleByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminAuthenticationRuleByID, unexpected response", ""))
@@ -1076,6 +1111,8 @@ func resourceDeviceAdministrationAuthenticationRulesDelete(ctx context.Context,
 	vvID := vID
 	vvName := vName
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -1118,9 +1155,9 @@ func resourceDeviceAdministrationAuthenticationRulesDelete(ctx context.Context,
 			if strings.Contains(restyResp1.String(), "400") &&
 				strings.Contains(restyResp1.String(), "Failed to handle API - Network Access Authentication Rule : Attempted to delete default Rule") {
 				log.Printf("\033[33m")
-				log.Printf("\n[WARNING] Failure when executing DeleteDeviceAdminAuthenticationRuleByID\n%v\n%v\n%s", err, restyResp1.String(), "\033[0m")
+				log.Printf("\n[WARNING] Failure when executing DeleteDeviceAdminAuthenticationRuleByID\n%v\n%v\n%s", err, redactSecrets(restyResp1.String()), "\033[0m")
 			} else {
-				log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing DeleteDeviceAdminAuthenticationRuleByID", err, restyResp1.String(),
 					"Failure at DeleteDeviceAdminAuthenticationRuleByID, unexpected response", ""))
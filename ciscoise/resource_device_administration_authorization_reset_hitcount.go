@@ -73,7 +73,7 @@ func resourceDeviceAdministrationAuthorizationResetHitcountCreate(ctx context.Co
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.ResetHitCountsDeviceAdminAuthorizationRules(vvPolicyID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminAuthorizationRules", err,
@@ -0,0 +1,68 @@
+package ciscoise
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+func deviceAdministrationAuthorizationRuleList() ruleList {
+	parameters := resourceDeviceAdministrationAuthorizationRules().Schema["parameters"].Elem.(*schema.Resource).Schema
+	return ruleList{
+		operation:  "DeviceAdminAuthorizationRule",
+		path:       "/api/v1/policy/device-admin/policy-set/%s/authorization",
+		conditions: deviceAdminRuleConditionJSON,
+		results: []ruleListResult{
+			{key: "commands", apiKey: "commands", schema: parameters["commands"]},
+			{key: "profile", apiKey: "profile", schema: parameters["profile"]},
+		},
+		list: func(client *isegosdk.Client, policyID string) (*resty.Response, error) {
+			_, restyResp, err := client.DeviceAdministrationAuthorizationRules.GetDeviceAdminAuthorizationRules(policyID)
+			return restyResp, err
+		},
+		delete: func(client *isegosdk.Client, policyID string, id string) (*resty.Response, error) {
+			_, restyResp, err := client.DeviceAdministrationAuthorizationRules.DeleteDeviceAdminAuthorizationRuleByID(policyID, id)
+			return restyResp, err
+		},
+	}
+}
+
+func resourceDeviceAdministrationAuthorizationRuleList() *schema.Resource {
+	return &schema.Resource{
+		Description: `It manages create, read, update, move and delete operations on Device Administration - Authorization Rules.
+- Manages the ordered list of authorization rules of a device administration policy set. The rank of each rule is its position in the list.
+- Only the rules whose attributes or rank differ are updated, so ranks do not collide as they do with one ciscoise_device_administration_authorization_rules resource per rule.
+- Rules of the policy set that are not in the list are reported in item.unmanaged_rules and kept below the listed rules. The default rule is never managed.
+`,
+
+		CreateContext: resourceDeviceAdministrationAuthorizationRuleListCreate,
+		ReadContext:   resourceDeviceAdministrationAuthorizationRuleListRead,
+		UpdateContext: resourceDeviceAdministrationAuthorizationRuleListUpdate,
+		DeleteContext: resourceDeviceAdministrationAuthorizationRuleListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: deviceAdministrationAuthorizationRuleList().importState,
+		},
+
+		Schema: deviceAdministrationAuthorizationRuleList().resourceSchema(),
+	}
+}
+
+func resourceDeviceAdministrationAuthorizationRuleListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deviceAdministrationAuthorizationRuleList().create(ctx, d, m)
+}
+
+func resourceDeviceAdministrationAuthorizationRuleListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deviceAdministrationAuthorizationRuleList().read(ctx, d, m)
+}
+
+func resourceDeviceAdministrationAuthorizationRuleListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deviceAdministrationAuthorizationRuleList().update(ctx, d, m)
+}
+
+func resourceDeviceAdministrationAuthorizationRuleListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return deviceAdministrationAuthorizationRuleList().remove(ctx, d, m)
+}
@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,7 +43,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceDeviceAdministrationAuthorizationRulesUpdate,
 		DeleteContext: resourceDeviceAdministrationAuthorizationRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateRuleByIDOrName(resourceDeviceAdministrationAuthorizationRulesRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -704,6 +705,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": resourceConditionExpressionSchema(),
+									"condition_name":       resourceConditionNameSchema(),
+									"condition_json":       resourceConditionJSONSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -773,6 +777,8 @@ func resourceDeviceAdministrationAuthorizationRulesCreate(ctx context.Context, d
 
 	vPolicyID, okPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvID string
 	var vvName string
@@ -819,7 +825,20 @@ func resourceDeviceAdministrationAuthorizationRulesCreate(ctx context.Context, d
 			}
 		}
 	}
-	resp1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.CreateDeviceAdminAuthorizationRule(vvPolicyID, request1)
+	var resp1 *isegosdk.ResponseDeviceAdministrationAuthorizationRulesCreateDeviceAdminAuthorizationRule
+	var restyResp1 *resty.Response
+	body, ok, err := deviceAdminRuleConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateDeviceAdminAuthorizationRule", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseDeviceAdministrationAuthorizationRulesCreateDeviceAdminAuthorizationRule{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateDeviceAdminAuthorizationRule", resty.MethodPost, "/api/v1/policy/device-admin/policy-set/"+vvPolicyID+"/authorization", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.DeviceAdministrationAuthorizationRules.CreateDeviceAdminAuthorizationRule(vvPolicyID, request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -886,10 +905,9 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminAuthorizationRules", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -897,8 +915,7 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 		items1 := getAllItemsDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRules(m, response1, vvPolicyID)
 		item1, err := searchDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRules(m, items1, vvName, vvID, vvPolicyID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDeviceAdminAuthorizationRules", err, nil)
 		}
 		vItem1 := flattenDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRuleByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -907,6 +924,9 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 				err))
 			return diags
 		}
+		if item1.Rule != nil {
+			deviceAdminRuleConditionJSON.flatten(d, vItem1, restyResp1, item1.Rule.ID)
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminAuthorizationRules search response",
@@ -924,10 +944,9 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminAuthorizationRuleByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -939,6 +958,7 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 				err))
 			return diags
 		}
+		deviceAdminRuleConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminAuthorizationRuleByID response",
@@ -980,6 +1000,8 @@ func resourceDeviceAdministrationAuthorizationRulesUpdate(ctx context.Context, d
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -1011,10 +1033,23 @@ func resourceDeviceAdministrationAuthorizationRulesUpdate(ctx context.Context, d
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.UpdateDeviceAdminAuthorizationRuleByID(vvPolicyID, vvID, request1)
+		var response1 *isegosdk.ResponseDeviceAdministrationAuthorizationRulesUpdateDeviceAdminAuthorizationRuleByID
+		var restyResp1 *resty.Response
+		body, ok, err := deviceAdminRuleConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateDeviceAdminAuthorizationRuleByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseDeviceAdministrationAuthorizationRulesUpdateDeviceAdminAuthorizationRuleByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateDeviceAdminAuthorizationRuleByID", resty.MethodPut, "/api/v1/policy/device-admin/policy-set/"+vvPolicyID+"/authorization/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.DeviceAdministrationAuthorizationRules.UpdateDeviceAdminAuthorizationRuleByID(vvPolicyID, vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminAuthorizationRuleByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminAuthorizationRuleByID, unexpected response", ""))
@@ -1060,6 +1095,8 @@ func resourceDeviceAdministrationAuthorizationRulesDelete(ctx context.Context, d
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -1098,7 +1135,7 @@ func resourceDeviceAdministrationAuthorizationRulesDelete(ctx context.Context, d
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.DeleteDeviceAdminAuthorizationRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminAuthorizationRuleByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminAuthorizationRuleByID, unexpected response", ""))
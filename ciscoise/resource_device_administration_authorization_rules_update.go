@@ -800,7 +800,7 @@ func resourceDeviceAdministrationAuthorizationRulesUpdateUpdateCreate(ctx contex
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			err = fmt.Errorf(restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
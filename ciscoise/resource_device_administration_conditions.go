@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -73,7 +74,7 @@ TimeAndDate
 		UpdateContext: resourceDeviceAdministrationConditionsUpdate,
 		DeleteContext: resourceDeviceAdministrationConditionsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceDeviceAdministrationConditionsRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -400,6 +401,7 @@ TimeAndDate
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
 						},
+						"condition_json": resourceConditionJSONSchema(),
 						"id": &schema.Schema{
 							Description:      `id path parameter. Condition id`,
 							Type:             schema.TypeString,
@@ -487,7 +489,20 @@ func resourceDeviceAdministrationConditionsCreate(ctx context.Context, d *schema
 			}
 		}
 	}
-	resp1, restyResp1, err := client.DeviceAdministrationConditions.CreateDeviceAdminCondition(request1)
+	var resp1 *isegosdk.ResponseDeviceAdministrationConditionsCreateDeviceAdminCondition
+	var restyResp1 *resty.Response
+	body, ok, err := deviceAdminLibraryConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateDeviceAdminCondition", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseDeviceAdministrationConditionsCreateDeviceAdminCondition{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateDeviceAdminCondition", resty.MethodPost, "/api/v1/policy/device-admin/condition", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.DeviceAdministrationConditions.CreateDeviceAdminCondition(request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -537,10 +552,9 @@ func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.R
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminConditionByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -552,6 +566,7 @@ func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.R
 				err))
 			return diags
 		}
+		deviceAdminLibraryConditionJSON.flatten(d, vItemName1, restyResp1, vID)
 		if err := d.Set("parameters", vItemName1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminConditionByName response",
@@ -569,10 +584,9 @@ func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.R
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminConditionByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -584,6 +598,7 @@ func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.R
 				err))
 			return diags
 		}
+		deviceAdminLibraryConditionJSON.flatten(d, vItemID2, restyResp2, vvID)
 		if err := d.Set("parameters", vItemID2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminConditionByID response",
@@ -639,10 +654,23 @@ func resourceDeviceAdministrationConditionsUpdate(ctx context.Context, d *schema
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.DeviceAdministrationConditions.UpdateDeviceAdminConditionByID(vvID, request1)
+		var response1 *isegosdk.ResponseDeviceAdministrationConditionsUpdateDeviceAdminConditionByID
+		var restyResp1 *resty.Response
+		body, ok, err := deviceAdminLibraryConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateDeviceAdminConditionByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseDeviceAdministrationConditionsUpdateDeviceAdminConditionByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateDeviceAdminConditionByID", resty.MethodPut, "/api/v1/policy/device-admin/condition/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.DeviceAdministrationConditions.UpdateDeviceAdminConditionByID(vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminConditionByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminConditionByID, unexpected response", ""))
@@ -702,7 +730,7 @@ func resourceDeviceAdministrationConditionsDelete(ctx context.Context, d *schema
 	response1, restyResp1, err := client.DeviceAdministrationConditions.DeleteDeviceAdminConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminConditionByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminConditionByID, unexpected response", ""))
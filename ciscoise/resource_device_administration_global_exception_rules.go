@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -43,7 +44,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceDeviceAdministrationGlobalExceptionRulesUpdate,
 		DeleteContext: resourceDeviceAdministrationGlobalExceptionRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateGlobalRuleByIDOrName(resourceDeviceAdministrationGlobalExceptionRulesRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -546,6 +547,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": resourceConditionExpressionSchema(),
+									"condition_name":       resourceConditionNameSchema(),
+									"condition_json":       resourceConditionJSONSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -606,6 +610,8 @@ func resourceDeviceAdministrationGlobalExceptionRulesCreate(ctx context.Context,
 	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
+	policySetMutexKV.Lock(deviceAdminGlobalExceptionRulesKey)
+	defer policySetMutexKV.Unlock(deviceAdminGlobalExceptionRulesKey)
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationGlobalExceptionRulesCreateDeviceAdminPolicySetGlobalException(ctx, "parameters.0", d)
@@ -656,7 +662,20 @@ func resourceDeviceAdministrationGlobalExceptionRulesCreate(ctx context.Context,
 			}
 		}
 	}
-	resp1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.CreateDeviceAdminPolicySetGlobalException(request1)
+	var resp1 *isegosdk.ResponseDeviceAdministrationAuthorizationGlobalExceptionRulesCreateDeviceAdminPolicySetGlobalException
+	var restyResp1 *resty.Response
+	body, ok, err := deviceAdminRuleConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateDeviceAdminPolicySetGlobalException", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseDeviceAdministrationAuthorizationGlobalExceptionRulesCreateDeviceAdminPolicySetGlobalException{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateDeviceAdminPolicySetGlobalException", resty.MethodPost, "/api/v1/policy/device-admin/policy-set/global-exception", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.DeviceAdministrationAuthorizationGlobalExceptionRules.CreateDeviceAdminPolicySetGlobalException(request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -721,10 +740,9 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminPolicySetGlobalExceptionRules", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -732,8 +750,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 		items1 := getAllItemsDeviceAdministrationAuthorizationGlobalExceptionRulesGetDeviceAdminPolicySetGlobalExceptionRules(m, response1)
 		item1, err := searchDeviceAdministrationAuthorizationGlobalExceptionRulesGetDeviceAdminPolicySetGlobalExceptionRules(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDeviceAdminPolicySetGlobalExceptionRules", err, nil)
 		}
 		vItem1 := flattenDeviceAdministrationAuthorizationGlobalExceptionRulesGetDeviceAdminPolicySetGlobalExceptionByRuleIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -742,6 +759,9 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
+		if item1.Rule != nil {
+			deviceAdminRuleConditionJSON.flatten(d, vItem1, restyResp1, item1.Rule.ID)
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminPolicySetGlobalExceptionRules search response",
@@ -756,10 +776,9 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminPolicySetGlobalExceptionByRuleID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -771,6 +790,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
+		deviceAdminRuleConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminPolicySetGlobalExceptionByRuleID response",
@@ -789,6 +809,8 @@ func resourceDeviceAdministrationGlobalExceptionRulesUpdate(ctx context.Context,
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
+	policySetMutexKV.Lock(deviceAdminGlobalExceptionRulesKey)
+	defer policySetMutexKV.Unlock(deviceAdminGlobalExceptionRulesKey)
 
 	resourceID := d.Id()
 	resourceMap := separateResourceID(resourceID)
@@ -841,10 +863,23 @@ func resourceDeviceAdministrationGlobalExceptionRulesUpdate(ctx context.Context,
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.UpdateDeviceAdminPolicySetGlobalExceptionByRuleID(vvID, request1)
+		var response1 *isegosdk.ResponseDeviceAdministrationAuthorizationGlobalExceptionRulesUpdateDeviceAdminPolicySetGlobalExceptionByRuleID
+		var restyResp1 *resty.Response
+		body, ok, err := deviceAdminRuleConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateDeviceAdminPolicySetGlobalExceptionByRuleID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseDeviceAdministrationAuthorizationGlobalExceptionRulesUpdateDeviceAdminPolicySetGlobalExceptionByRuleID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateDeviceAdminPolicySetGlobalExceptionByRuleID", resty.MethodPut, "/api/v1/policy/device-admin/policy-set/global-exception/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.DeviceAdministrationAuthorizationGlobalExceptionRules.UpdateDeviceAdminPolicySetGlobalExceptionByRuleID(vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminPolicySetGlobalExceptionByRuleID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminPolicySetGlobalExceptionByRuleID, unexpected response", ""))
@@ -867,6 +902,8 @@ func resourceDeviceAdministrationGlobalExceptionRulesDelete(ctx context.Context,
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
+	policySetMutexKV.Lock(deviceAdminGlobalExceptionRulesKey)
+	defer policySetMutexKV.Unlock(deviceAdminGlobalExceptionRulesKey)
 
 	resourceID := d.Id()
 	resourceMap := separateResourceID(resourceID)
@@ -926,7 +963,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesDelete(ctx context.Context,
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.DeleteDeviceAdminPolicySetGlobalExceptionByRuleID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminPolicySetGlobalExceptionByRuleID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminPolicySetGlobalExceptionByRuleID, unexpected response", ""))
@@ -64,7 +64,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesResetHitcountCreate(ctx con
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.ResetHitCountsDeviceAdminGlobalExceptions()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminGlobalExceptions", err,
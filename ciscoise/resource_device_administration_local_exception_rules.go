@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -43,7 +44,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceDeviceAdministrationLocalExceptionRulesUpdate,
 		DeleteContext: resourceDeviceAdministrationLocalExceptionRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateRuleByIDOrName(resourceDeviceAdministrationLocalExceptionRulesRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -551,6 +552,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": resourceConditionExpressionSchema(),
+									"condition_name":       resourceConditionNameSchema(),
+									"condition_json":       resourceConditionJSONSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -620,6 +624,8 @@ func resourceDeviceAdministrationLocalExceptionRulesCreate(ctx context.Context,
 
 	vPolicyID, okPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvName string
 	var vvID string
@@ -665,7 +671,20 @@ func resourceDeviceAdministrationLocalExceptionRulesCreate(ctx context.Context,
 			}
 		}
 	}
-	resp1, restyResp1, err := client.DeviceAdministrationAuthorizationExceptionRules.CreateDeviceAdminLocalExceptionRule(vvPolicyID, request1)
+	var resp1 *isegosdk.ResponseDeviceAdministrationAuthorizationExceptionRulesCreateDeviceAdminLocalExceptionRule
+	var restyResp1 *resty.Response
+	body, ok, err := deviceAdminRuleConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateDeviceAdminLocalExceptionRule", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseDeviceAdministrationAuthorizationExceptionRulesCreateDeviceAdminLocalExceptionRule{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateDeviceAdminLocalExceptionRule", resty.MethodPost, "/api/v1/policy/device-admin/policy-set/"+vvPolicyID+"/exception", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.DeviceAdministrationAuthorizationExceptionRules.CreateDeviceAdminLocalExceptionRule(vvPolicyID, request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -733,10 +752,9 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminLocalExceptionRules", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -744,8 +762,7 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 		items1 := getAllItemsDeviceAdministrationAuthorizationExceptionRulesGetDeviceAdminLocalExceptionRules(m, response1, vvPolicyID)
 		item1, err := searchDeviceAdministrationAuthorizationExceptionRulesGetDeviceAdminLocalExceptionRules(m, items1, vvName, vvID, vvPolicyID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDeviceAdminLocalExceptionRules", err, nil)
 		}
 		vItem1 := flattenDeviceAdministrationAuthorizationExceptionRulesGetDeviceAdminLocalExceptionRuleByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -754,6 +771,9 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
+		if item1.Rule != nil {
+			deviceAdminRuleConditionJSON.flatten(d, vItem1, restyResp1, item1.Rule.ID)
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminLocalExceptionRules search response",
@@ -768,10 +788,9 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminLocalExceptionRuleByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -783,6 +802,7 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
+		deviceAdminRuleConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminLocalExceptionRuleByID response",
@@ -824,6 +844,8 @@ func resourceDeviceAdministrationLocalExceptionRulesUpdate(ctx context.Context,
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -855,10 +877,23 @@ func resourceDeviceAdministrationLocalExceptionRulesUpdate(ctx context.Context,
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.DeviceAdministrationAuthorizationExceptionRules.UpdateDeviceAdminLocalExceptionRuleByID(vvPolicyID, vvID, request1)
+		var response1 *isegosdk.ResponseDeviceAdministrationAuthorizationExceptionRulesUpdateDeviceAdminLocalExceptionRuleByID
+		var restyResp1 *resty.Response
+		body, ok, err := deviceAdminRuleConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateDeviceAdminLocalExceptionRuleByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseDeviceAdministrationAuthorizationExceptionRulesUpdateDeviceAdminLocalExceptionRuleByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateDeviceAdminLocalExceptionRuleByID", resty.MethodPut, "/api/v1/policy/device-admin/policy-set/"+vvPolicyID+"/exception/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.DeviceAdministrationAuthorizationExceptionRules.UpdateDeviceAdminLocalExceptionRuleByID(vvPolicyID, vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminLocalExceptionRuleByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminLocalExceptionRuleByID, unexpected response", ""))
@@ -904,6 +939,8 @@ func resourceDeviceAdministrationLocalExceptionRulesDelete(ctx context.Context,
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -942,7 +979,7 @@ func resourceDeviceAdministrationLocalExceptionRulesDelete(ctx context.Context,
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationExceptionRules.DeleteDeviceAdminLocalExceptionRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminLocalExceptionRuleByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminLocalExceptionRuleByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceDeviceAdministrationNetworkConditions() *schema.Resource {
 		UpdateContext: resourceDeviceAdministrationNetworkConditionsUpdate,
 		DeleteContext: resourceDeviceAdministrationNetworkConditionsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceDeviceAdministrationNetworkConditionsRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -411,10 +411,9 @@ func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *s
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminNetworkConditions", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -422,8 +421,7 @@ func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *s
 		items1 := getAllItemsDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditions(m, response1)
 		item1, err := searchDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditions(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDeviceAdminNetworkConditions", err, nil)
 		}
 		vItem1 := flattenDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditionByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -448,10 +446,9 @@ func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *s
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminNetworkConditionByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -521,7 +518,7 @@ func resourceDeviceAdministrationNetworkConditionsUpdate(ctx context.Context, d
 		response1, restyResp1, err := client.DeviceAdministrationNetworkConditions.UpdateDeviceAdminNetworkConditionByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminNetworkConditionByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminNetworkConditionByID, unexpected response", ""))
@@ -588,7 +585,7 @@ func resourceDeviceAdministrationNetworkConditionsDelete(ctx context.Context, d
 	response1, restyResp1, err := client.DeviceAdministrationNetworkConditions.DeleteDeviceAdminNetworkConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminNetworkConditionByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminNetworkConditionByID, unexpected response", ""))
@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -41,7 +42,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceDeviceAdministrationPolicySetUpdate,
 		DeleteContext: resourceDeviceAdministrationPolicySetDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceDeviceAdministrationPolicySetRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -635,6 +636,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 								},
 							},
 						},
+						"condition_expression": resourceConditionExpressionSchema(),
+						"condition_name":       resourceConditionNameSchema(),
+						"condition_json":       resourceConditionJSONSchema(),
 						"default": &schema.Schema{
 							Description:      `Flag which indicates if this policy set is the default one`,
 							Type:             schema.TypeString,
@@ -770,7 +774,20 @@ func resourceDeviceAdministrationPolicySetCreate(ctx context.Context, d *schema.
 			}
 		}
 	}
-	resp1, restyResp1, err := client.DeviceAdministrationPolicySet.CreateDeviceAdminPolicySet(request1)
+	var resp1 *isegosdk.ResponseDeviceAdministrationPolicySetCreateDeviceAdminPolicySet
+	var restyResp1 *resty.Response
+	body, ok, err := deviceAdminPolicySetConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateDeviceAdminPolicySet", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseDeviceAdministrationPolicySetCreateDeviceAdminPolicySet{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateDeviceAdminPolicySet", resty.MethodPost, "/api/v1/policy/device-admin/policy-set", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.DeviceAdministrationPolicySet.CreateDeviceAdminPolicySet(request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -820,10 +837,9 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminPolicySets", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -831,8 +847,7 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 		items1 := getAllItemsDeviceAdministrationPolicySetGetDeviceAdminPolicySets(m, response1)
 		item1, err := searchDeviceAdministrationPolicySetGetDeviceAdminPolicySets(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDeviceAdminPolicySets", err, nil)
 		}
 		vItem1 := flattenDeviceAdministrationPolicySetGetDeviceAdminPolicySetByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -841,6 +856,7 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 				err))
 			return diags
 		}
+		deviceAdminPolicySetConditionJSON.flatten(d, vItem1, restyResp1, item1.ID)
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminPolicySets search response",
@@ -856,10 +872,9 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminPolicySetByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -871,6 +886,7 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 				err))
 			return diags
 		}
+		deviceAdminPolicySetConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminPolicySetByID response",
@@ -926,10 +942,23 @@ func resourceDeviceAdministrationPolicySetUpdate(ctx context.Context, d *schema.
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.DeviceAdministrationPolicySet.UpdateDeviceAdminPolicySetByID(vvID, request1)
+		var response1 *isegosdk.ResponseDeviceAdministrationPolicySetUpdateDeviceAdminPolicySetByID
+		var restyResp1 *resty.Response
+		body, ok, err := deviceAdminPolicySetConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateDeviceAdminPolicySetByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseDeviceAdministrationPolicySetUpdateDeviceAdminPolicySetByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateDeviceAdminPolicySetByID", resty.MethodPut, "/api/v1/policy/device-admin/policy-set/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.DeviceAdministrationPolicySet.UpdateDeviceAdminPolicySetByID(vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminPolicySetByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminPolicySetByID, unexpected response", ""))
@@ -995,7 +1024,7 @@ func resourceDeviceAdministrationPolicySetDelete(ctx context.Context, d *schema.
 	response1, restyResp1, err := client.DeviceAdministrationPolicySet.DeleteDeviceAdminPolicySetByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminPolicySetByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminPolicySetByID, unexpected response", ""))
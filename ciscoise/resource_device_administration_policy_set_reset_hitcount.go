@@ -63,7 +63,7 @@ func resourceDeviceAdministrationPolicySetResetHitcountCreate(ctx context.Contex
 	response1, restyResp1, err := client.DeviceAdministrationPolicySet.ResetHitCountsDeviceAdminPolicySets()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminPolicySets", err,
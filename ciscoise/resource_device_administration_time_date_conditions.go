@@ -29,7 +29,7 @@ func resourceDeviceAdministrationTimeDateConditions() *schema.Resource {
 		UpdateContext: resourceDeviceAdministrationTimeDateConditionsUpdate,
 		DeleteContext: resourceDeviceAdministrationTimeDateConditionsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceDeviceAdministrationTimeDateConditionsRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -621,10 +621,9 @@ func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminTimeConditions", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -632,8 +631,7 @@ func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *
 		items1 := getAllItemsDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditions(m, response1)
 		item1, err := searchDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditions(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDeviceAdminTimeConditions", err, nil)
 		}
 		vItem1 := flattenDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditionByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -657,10 +655,9 @@ func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeviceAdminTimeConditionByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -730,7 +727,7 @@ func resourceDeviceAdministrationTimeDateConditionsUpdate(ctx context.Context, d
 		response1, restyResp1, err := client.DeviceAdministrationTimeDateConditions.UpdateDeviceAdminTimeConditionByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminTimeConditionByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminTimeConditionByID, unexpected response", ""))
@@ -796,7 +793,7 @@ func resourceDeviceAdministrationTimeDateConditionsDelete(ctx context.Context, d
 	response1, restyResp1, err := client.DeviceAdministrationTimeDateConditions.DeleteDeviceAdminTimeConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminTimeConditionByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminTimeConditionByID, unexpected response", ""))
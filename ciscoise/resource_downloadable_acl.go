@@ -29,7 +29,7 @@ func resourceDownloadableACL() *schema.Resource {
 		UpdateContext: resourceDownloadableACLUpdate,
 		DeleteContext: resourceDownloadableACLDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceDownloadableACLRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -265,10 +265,9 @@ func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDownloadableACL", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -276,8 +275,7 @@ func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m
 		items1 := getAllItemsDownloadableACLGetDownloadableACL(m, response1, &queryParams1)
 		item1, err := searchDownloadableACLGetDownloadableACL(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDownloadableACL", err, nil)
 		}
 		vItem1 := flattenDownloadableACLGetDownloadableACLByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -302,10 +300,9 @@ func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDownloadableACLByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -377,7 +374,7 @@ func resourceDownloadableACLUpdate(ctx context.Context, d *schema.ResourceData,
 		response1, restyResp1, err := client.DownloadableACL.UpdateDownloadableACLByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDownloadableACLByID", err, restyResp1.String(),
 					"Failure at UpdateDownloadableACLByID, unexpected response", ""))
@@ -445,7 +442,7 @@ func resourceDownloadableACLDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.DownloadableACL.DeleteDownloadableACLByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDownloadableACLByID", err, restyResp1.String(),
 				"Failure at DeleteDownloadableACLByID, unexpected response", ""))
@@ -0,0 +1,542 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEgressMatrix() *schema.Resource {
+	return &schema.Resource{
+		Description: `It manages the TrustSec egress matrix as a whole.
+- Holds the desired cells of the matrix, each identified by its source and destination security groups, given by id or by name.
+- Cells can also be given in cells_csv, in the egress policy CSV format of the ISE GUI export, as rendered by ciscoise_egress_matrix_csv. The description of these cells is left as is on ISE.
+- On apply, the cells are compared with the matrix on ISE and only the cells that differ are pushed, with one EgressMatrixCell bulk request per operation.
+- With exclusive set, cells on ISE that are not in the configuration are deleted, and destroying the resource clears all the cells like ciscoise_egress_matrix_cell_clear_all. Otherwise only the cells removed from the configuration are deleted. The ANY-ANY default cell is never deleted.
+- ISE only lists the cell names, so reading a cell costs one request. Without exclusive, refresh lists the matrix and reads only the managed cells. With exclusive, or when the configuration has cells not yet managed, every cell of the matrix is read.
+- Import adopts all the cells of the matrix except the default cell.
+`,
+
+		CreateContext: resourceEgressMatrixCreate,
+		ReadContext:   resourceEgressMatrixRead,
+		UpdateContext: resourceEgressMatrixUpdate,
+		DeleteContext: resourceEgressMatrixDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEgressMatrixImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(BULK_REQUEST_TIMEOUT),
+			Update: schema.DefaultTimeout(BULK_REQUEST_TIMEOUT),
+			Delete: schema.DefaultTimeout(BULK_REQUEST_TIMEOUT),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: `Unix timestamp records the last time that the resource was updated.`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"item": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cells": &schema.Schema{
+							Description: `Managed cells`,
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination_sgt_id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_sgt_id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cells": &schema.Schema{
+							Description: `Cells of the matrix`,
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: resourceEgressMatrixCellSchema(),
+							},
+						},
+						"cells_csv": &schema.Schema{
+							Description: `Cells of the matrix in the egress policy CSV format, with the Source SGT, Destination SGT, SGACL Name and Rule Status columns. A last SGACL Name of Permit IP or Deny IP is the default rule. Cells must not be listed in both cells and cells_csv.`,
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"exclusive": &schema.Schema{
+							Description:  `Delete the cells on ISE that are not in cells or cells_csv, except the default cell. Every cell of the matrix is then read on refresh, with one request per cell`,
+							Type:         schema.TypeString,
+							ValidateFunc: validateStringHasValueFunc([]string{"", "true", "false"}),
+							Optional:     true,
+							Default:      "false",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEgressMatrixCellSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"default_rule": &schema.Schema{
+			Description: `Allowed values:
+- NONE,
+- DENY_IP,
+- PERMIT_IP`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "NONE",
+			ValidateFunc: validateStringHasValueFunc([]string{"NONE", "DENY_IP", "PERMIT_IP"}),
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"destination_sgt_id": &schema.Schema{
+			Description: `Destination security group id. Set it or destination_sgt_name.`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"destination_sgt_name": &schema.Schema{
+			Description: `Destination security group name, resolved to its id`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"matrix_cell_status": &schema.Schema{
+			Description: `Allowed values:
+- DISABLED,
+- ENABLED,
+- MONITOR`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "ENABLED",
+			ValidateFunc: validateStringHasValueFunc([]string{"DISABLED", "ENABLED", "MONITOR"}),
+		},
+		"sgacl_names": &schema.Schema{
+			Description: `Security group ACL names, in order, resolved to their ids. Set it or sgacls.`,
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"sgacls": &schema.Schema{
+			Description: `Security group ACL ids, in order`,
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"source_sgt_id": &schema.Schema{
+			Description: `Source security group id. Set it or source_sgt_name.`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"source_sgt_name": &schema.Schema{
+			Description: `Source security group name, resolved to its id`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+}
+
+func resourceEgressMatrixCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning EgressMatrix create")
+	diags := resourceEgressMatrixApply(ctx, d, m, d.Timeout(schema.TimeoutCreate), map[string]bool{})
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	return append(diags, resourceEgressMatrixRead(ctx, d, m)...)
+}
+
+func resourceEgressMatrixRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+	var diags diag.Diagnostics
+	log.Printf("[DEBUG] Beginning EgressMatrix read for id=[%s]", d.Id())
+
+	resolver := newEgressMatrixResolver(client)
+	prior := map[string]map[string]interface{}{}
+	keys := map[string]bool{}
+	for _, v := range resourceEgressMatrixConfiguredCells(d) {
+		cell, err := expandEgressMatrixCell(resolver, v)
+		if err != nil {
+			log.Printf("[WARN] Unable to resolve egress matrix cell: %v", err)
+			continue
+		}
+		prior[cell.key()] = v
+		keys[cell.key()] = true
+	}
+	priorCSV := interfaceToString(d.Get("parameters.0.cells_csv"))
+	csvKeys := map[string]bool{}
+	if priorCSV != "" {
+		if cells, _, err := resolver.csvCells(priorCSV); err == nil {
+			for _, cell := range cells {
+				csvKeys[cell.key()] = true
+				keys[cell.key()] = true
+			}
+		} else {
+			log.Printf("[WARN] Unable to read egress matrix cells_csv: %v", err)
+			priorCSV = ""
+		}
+	}
+	exclusive := interfaceToString(d.Get("parameters.0.exclusive")) == "true"
+
+	current, restyResp1, err := resourceEgressMatrixCurrentCells(d, m, exclusive, keys)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing GetEgressMatrixCell", err, restyResp1))
+		return diags
+	}
+	vCellsCSV := ""
+	if priorCSV != "" {
+		if vCellsCSV, err = flattenEgressMatrixCSV(m, resolver, current, priorCSV); err != nil {
+			diags = append(diags, diagError(
+				"Failure when rendering EgressMatrix cells_csv", err))
+			return diags
+		}
+	}
+
+	vCells := []interface{}{}
+	vItemCells := []map[string]interface{}{}
+	for _, cell := range current {
+		priorCell, managed := prior[cell.key()]
+		if csvKeys[cell.key()] {
+			managed = true
+		} else if managed || (exclusive && !cell.isDefault()) {
+			vCells = append(vCells, flattenEgressMatrixCell(resolver, cell, priorCell))
+		}
+		if managed {
+			vItemCells = append(vItemCells, map[string]interface{}{
+				"id":                 cell.id,
+				"name":               cell.name,
+				"source_sgt_id":      cell.sourceSgtID,
+				"destination_sgt_id": cell.destinationSgtID,
+			})
+		}
+	}
+	vItem := []map[string]interface{}{
+		{
+			"cells": vItemCells,
+		},
+	}
+	if err := d.Set("item", vItem); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting GetEgressMatrixCell response",
+			err))
+		return diags
+	}
+	vParameters := []map[string]interface{}{
+		{
+			"cells":     vCells,
+			"cells_csv": vCellsCSV,
+			"exclusive": fmt.Sprintf("%t", exclusive),
+		},
+	}
+	if err := d.Set("parameters", vParameters); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting GetEgressMatrixCell response to parameters",
+			err))
+		return diags
+	}
+	return diags
+}
+
+func resourceEgressMatrixUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning EgressMatrix update for id=[%s]", d.Id())
+	if d.HasChange("parameters") {
+		managed := map[string]bool{}
+		for key := range resourceEgressMatrixManagedCells(d) {
+			managed[key] = true
+		}
+		diags := resourceEgressMatrixApply(ctx, d, m, d.Timeout(schema.TimeoutUpdate), managed)
+		if diags.HasError() {
+			return diags
+		}
+		_ = d.Set("last_updated", getUnixTimeString())
+		return append(diags, resourceEgressMatrixRead(ctx, d, m)...)
+	}
+	return resourceEgressMatrixRead(ctx, d, m)
+}
+
+func resourceEgressMatrixDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning EgressMatrix delete for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+	var diags diag.Diagnostics
+
+	if interfaceToString(d.Get("parameters.0.exclusive")) == "true" {
+		restyResp1, err := client.EgressMatrixCell.ClearAllMatrixCells()
+		if err != nil {
+			diags = append(diags, diagErrorWithOptionalResponse(
+				"Failure when executing ClearAllMatrixCells", err, restyResp1))
+			return diags
+		}
+		d.SetId("")
+		return diags
+	}
+	changes := egressMatrixChanges{}
+	if cells, ok := d.Get("item.0.cells").([]interface{}); ok {
+		for _, v := range cells {
+			if cell, ok := v.(map[string]interface{}); ok {
+				matrixCell := egressMatrixCell{id: interfaceToString(cell["id"]), name: interfaceToString(cell["name"])}
+				if !matrixCell.isDefault() {
+					changes.delete = append(changes.delete, matrixCell)
+				}
+			}
+		}
+	}
+	if diags := applyEgressMatrixChanges(ctx, clientConfig, d.Timeout(schema.TimeoutDelete), changes); diags.HasError() {
+		return diags
+	}
+	d.SetId("")
+	return diags
+}
+
+// resourceEgressMatrixImport adopts all the cells of the matrix except the
+// default cell, whatever the import ID.
+func resourceEgressMatrixImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	current, _, err := readEgressMatrixCells(m, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the egress matrix: %v", err)
+	}
+	vCells := []interface{}{}
+	for _, cell := range current {
+		if !cell.isDefault() {
+			vCells = append(vCells, flattenEgressMatrixCell(nil, cell, nil))
+		}
+	}
+	if err := d.Set("parameters", []map[string]interface{}{{"cells": vCells, "exclusive": "false"}}); err != nil {
+		return nil, err
+	}
+	d.SetId(getUnixTimeString())
+	if err := diagsToError(resourceEgressMatrixRead(ctx, d, m)); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceEgressMatrixApply pushes the changes between the configured cells
+// and the matrix on ISE. managed are the keys of the cells previously
+// applied, deleted when no longer configured.
+func resourceEgressMatrixApply(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration, managed map[string]bool) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	var diags diag.Diagnostics
+
+	resolver := newEgressMatrixResolver(clientConfig.Client)
+	desired := []egressMatrixCell{}
+	keys := map[string]bool{}
+	for _, v := range resourceEgressMatrixConfiguredCells(d) {
+		cell, err := expandEgressMatrixCell(resolver, v)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding EgressMatrix", err))
+			return diags
+		}
+		if keys[cell.key()] {
+			diags = append(diags, diagError(
+				"Failure when expanding EgressMatrix", fmt.Errorf("cell %s is listed more than once", cell.key())))
+			return diags
+		}
+		keys[cell.key()] = true
+		desired = append(desired, cell)
+	}
+	csvCells := []egressMatrixCell{}
+	if v := interfaceToString(d.Get("parameters.0.cells_csv")); v != "" {
+		cells, _, err := resolver.csvCells(v)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding EgressMatrix cells_csv", err))
+			return diags
+		}
+		for _, cell := range cells {
+			if keys[cell.key()] {
+				diags = append(diags, diagError(
+					"Failure when expanding EgressMatrix cells_csv", fmt.Errorf("cell %s is listed in both cells and cells_csv", cell.key())))
+				return diags
+			}
+			keys[cell.key()] = true
+			csvCells = append(csvCells, cell)
+		}
+	}
+	exclusive := interfaceToString(d.Get("parameters.0.exclusive")) == "true"
+	current, restyResp1, err := resourceEgressMatrixCurrentCells(d, m, exclusive, keys)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing GetEgressMatrixCell", err, restyResp1))
+		return diags
+	}
+	descriptions := map[string]string{}
+	for _, cell := range current {
+		descriptions[cell.key()] = cell.description
+	}
+	for _, cell := range csvCells {
+		cell.description = descriptions[cell.key()]
+		desired = append(desired, cell)
+	}
+	changes := diffEgressMatrix(current, desired, managed, exclusive)
+	log.Printf("[DEBUG] EgressMatrix changes: %d to create, %d to update, %d to delete", len(changes.create), len(changes.update), len(changes.delete))
+	if changes.empty() {
+		return diags
+	}
+	return applyEgressMatrixChanges(ctx, clientConfig, timeout, changes)
+}
+
+// resourceEgressMatrixManagedCells returns the ids of the cells previously
+// applied, by key.
+func resourceEgressMatrixManagedCells(d *schema.ResourceData) map[string]string {
+	managed := map[string]string{}
+	if cells, ok := d.Get("item.0.cells").([]interface{}); ok {
+		for _, v := range cells {
+			if cell, ok := v.(map[string]interface{}); ok {
+				managed[interfaceToString(cell["source_sgt_id"])+"/"+interfaceToString(cell["destination_sgt_id"])] = interfaceToString(cell["id"])
+			}
+		}
+	}
+	return managed
+}
+
+// resourceEgressMatrixCurrentCells reads the cells on ISE to compare with the
+// cells of keys. Each cell costs one request, so without exclusive, when all
+// the cells of keys were previously applied, only the managed cells are read.
+// Otherwise the whole matrix is read, to find the cells not yet managed.
+func resourceEgressMatrixCurrentCells(d *schema.ResourceData, m interface{}, exclusive bool, keys map[string]bool) ([]egressMatrixCell, *resty.Response, error) {
+	if exclusive {
+		return readEgressMatrixCells(m, nil)
+	}
+	managed := resourceEgressMatrixManagedCells(d)
+	for key := range keys {
+		if _, ok := managed[key]; !ok {
+			return readEgressMatrixCells(m, nil)
+		}
+	}
+	ids := map[string]bool{}
+	for _, id := range managed {
+		ids[id] = true
+	}
+	return readEgressMatrixCells(m, ids)
+}
+
+func resourceEgressMatrixConfiguredCells(d *schema.ResourceData) []map[string]interface{} {
+	cells := []map[string]interface{}{}
+	set, ok := d.Get("parameters.0.cells").(*schema.Set)
+	if !ok {
+		return cells
+	}
+	for _, v := range set.List() {
+		if cell, ok := v.(map[string]interface{}); ok {
+			cells = append(cells, cell)
+		}
+	}
+	return cells
+}
+
+// expandEgressMatrixCell returns the cell described by v, an element of
+// parameters.cells, with its names resolved to ids.
+func expandEgressMatrixCell(resolver *egressMatrixResolver, v map[string]interface{}) (egressMatrixCell, error) {
+	cell := egressMatrixCell{
+		description:      conditionString(v, "description"),
+		matrixCellStatus: conditionString(v, "matrix_cell_status"),
+		defaultRule:      conditionString(v, "default_rule"),
+	}
+	var err error
+	if cell.sourceSgtID, err = expandEgressMatrixCellSgt(resolver, v, "source_sgt"); err != nil {
+		return cell, err
+	}
+	if cell.destinationSgtID, err = expandEgressMatrixCellSgt(resolver, v, "destination_sgt"); err != nil {
+		return cell, err
+	}
+	sgacls := interfaceToSliceString(v["sgacls"])
+	sgaclNames := interfaceToSliceString(v["sgacl_names"])
+	switch {
+	case len(sgacls) > 0 && len(sgaclNames) > 0:
+		return cell, fmt.Errorf("cell %s: set only one of sgacls and sgacl_names", cell.key())
+	case len(sgaclNames) > 0:
+		if cell.sgacls, err = resolver.sgaclIDs(sgaclNames); err != nil {
+			return cell, err
+		}
+	default:
+		cell.sgacls = sgacls
+	}
+	return cell, nil
+}
+
+func expandEgressMatrixCellSgt(resolver *egressMatrixResolver, v map[string]interface{}, prefix string) (string, error) {
+	id := conditionString(v, prefix+"_id")
+	name := conditionString(v, prefix+"_name")
+	switch {
+	case id != "" && name != "":
+		return "", fmt.Errorf("set only one of %s_id and %s_name", prefix, prefix)
+	case name != "":
+		return resolver.sgtID(name)
+	case id == "":
+		return "", fmt.Errorf("one of %s_id and %s_name is required", prefix, prefix)
+	}
+	return id, nil
+}
+
+// flattenEgressMatrixCell returns the parameters of a cell read from ISE. The
+// security groups and ACLs are named when the prior cell named them and the
+// names still resolve to the ids on ISE.
+func flattenEgressMatrixCell(resolver *egressMatrixResolver, cell egressMatrixCell, prior map[string]interface{}) map[string]interface{} {
+	respItem := map[string]interface{}{
+		"description":        cell.description,
+		"matrix_cell_status": cell.matrixCellStatus,
+		"default_rule":       cell.defaultRule,
+		"source_sgt_id":      cell.sourceSgtID,
+		"destination_sgt_id": cell.destinationSgtID,
+		"sgacls":             nonNilStrings(cell.sgacls),
+	}
+	if prior == nil {
+		return respItem
+	}
+	for _, prefix := range []string{"source_sgt", "destination_sgt"} {
+		if name := conditionString(prior, prefix+"_name"); name != "" {
+			respItem[prefix+"_name"] = name
+			respItem[prefix+"_id"] = ""
+		}
+	}
+	if names := interfaceToSliceString(prior["sgacl_names"]); len(names) > 0 {
+		if ids, err := resolver.sgaclIDs(names); err == nil && reflect.DeepEqual(ids, nonNilStrings(cell.sgacls)) {
+			respItem["sgacl_names"] = names
+			respItem["sgacls"] = []string{}
+		}
+	}
+	return respItem
+}
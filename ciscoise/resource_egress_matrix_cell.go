@@ -22,6 +22,8 @@ func resourceEgressMatrixCell() *schema.Resource {
 - This resource deletes an egress matrix cell.
 
 - This resource creates an egress matrix cell.
+
+- The cell can also be given as csv, a header and one row in the egress policy CSV format of the ISE GUI export.
 `,
 
 		CreateContext: resourceEgressMatrixCellCreate,
@@ -29,7 +31,7 @@ func resourceEgressMatrixCell() *schema.Resource {
 		UpdateContext: resourceEgressMatrixCellUpdate,
 		DeleteContext: resourceEgressMatrixCellDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceEgressMatrixCellRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -119,6 +121,21 @@ func resourceEgressMatrixCell() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 
+						"csv": &schema.Schema{
+							Description: `Cell in the egress policy CSV format, a header with the Source SGT, Destination SGT, SGACL Name and Rule Status columns and one row, where a last SGACL Name of Permit IP or Deny IP is the default rule, instead of the security group, security group ACL, default rule and status attributes`,
+							Type:        schema.TypeString,
+							Optional:    true,
+							ConflictsWith: []string{
+								"parameters.0.default_rule",
+								"parameters.0.destination_sgt_id",
+								"parameters.0.destination_sgt_name",
+								"parameters.0.matrix_cell_status",
+								"parameters.0.sgacl_names",
+								"parameters.0.sgacls",
+								"parameters.0.source_sgt_id",
+								"parameters.0.source_sgt_name",
+							},
+						},
 						"default_rule": &schema.Schema{
 							Description: `Allowed values:
 		- NONE,
@@ -141,6 +158,12 @@ func resourceEgressMatrixCell() *schema.Resource {
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
 						},
+						"destination_sgt_name": &schema.Schema{
+							Description:   `Name of the destination security group, resolved to destination_sgt_id`,
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"parameters.0.destination_sgt_id"},
+						},
 						"id": &schema.Schema{
 							Type:             schema.TypeString,
 							Optional:         true,
@@ -193,12 +216,27 @@ func resourceEgressMatrixCell() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"sgacl_names": &schema.Schema{
+							Description:   `Names of the security group ACLs, resolved to sgacls`,
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"parameters.0.sgacls"},
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						"source_sgt_id": &schema.Schema{
 							Type:             schema.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
 						},
+						"source_sgt_name": &schema.Schema{
+							Description:   `Name of the source security group, resolved to source_sgt_id`,
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"parameters.0.source_sgt_id"},
+						},
 					},
 				},
 			},
@@ -216,6 +254,36 @@ func resourceEgressMatrixCellCreate(ctx context.Context, d *schema.ResourceData,
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestEgressMatrixCellCreateEgressMatrixCell(ctx, "parameters.0", d)
+	if request1 != nil && request1.EgressMatrixCell != nil {
+		sourceSgtID, destinationSgtID, sgacls, err := expandEgressMatrixCellNameReferences(client, "parameters.0", d)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when resolving EgressMatrixCell names", err))
+			return diags
+		}
+		if sourceSgtID != "" {
+			request1.EgressMatrixCell.SourceSgtID = sourceSgtID
+		}
+		if destinationSgtID != "" {
+			request1.EgressMatrixCell.DestinationSgtID = destinationSgtID
+		}
+		if sgacls != nil {
+			request1.EgressMatrixCell.Sgacls = sgacls
+		}
+		cell, err := expandEgressMatrixCellCSV(client, "parameters.0", d)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding EgressMatrixCell csv", err))
+			return diags
+		}
+		if cell != nil {
+			request1.EgressMatrixCell.SourceSgtID = cell.sourceSgtID
+			request1.EgressMatrixCell.DestinationSgtID = cell.destinationSgtID
+			request1.EgressMatrixCell.Sgacls = cell.sgacls
+			request1.EgressMatrixCell.DefaultRule = cell.defaultRule
+			request1.EgressMatrixCell.MatrixCellStatus = cell.matrixCellStatus
+		}
+	}
 	if request1 != nil {
 		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 	}
@@ -304,10 +372,9 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetEgressMatrixCell", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -315,8 +382,7 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 		items1 := getAllItemsEgressMatrixCellGetEgressMatrixCell(m, response1, &queryParams1)
 		item1, err := searchEgressMatrixCellGetEgressMatrixCell(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetEgressMatrixCell", err, nil)
 		}
 		vItem1 := flattenEgressMatrixCellGetEgressMatrixCellByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -325,6 +391,13 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
+		flattenEgressMatrixCellNameReferences(client, d, vItem1)
+		if err := flattenEgressMatrixCellCSV(m, d, item1, vItem1); err != nil {
+			diags = append(diags, diagError(
+				"Failure when rendering EgressMatrixCell csv",
+				err))
+			return diags
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetEgressMatrixCell search response",
@@ -340,10 +413,9 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetEgressMatrixCellByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -355,6 +427,13 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
+		flattenEgressMatrixCellNameReferences(client, d, vItem2)
+		if err := flattenEgressMatrixCellCSV(m, d, response2.EgressMatrixCell, vItem2); err != nil {
+			diags = append(diags, diagError(
+				"Failure when rendering EgressMatrixCell csv",
+				err))
+			return diags
+		}
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetEgressMatrixCellByID response",
@@ -410,13 +489,43 @@ func resourceEgressMatrixCellUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChange("parameters") {
 		log.Printf("[DEBUG] ID used for update operation %s", vvID)
 		request1 := expandRequestEgressMatrixCellUpdateEgressMatrixCellByID(ctx, "parameters.0", d)
+		if request1 != nil && request1.EgressMatrixCell != nil {
+			sourceSgtID, destinationSgtID, sgacls, err := expandEgressMatrixCellNameReferences(client, "parameters.0", d)
+			if err != nil {
+				diags = append(diags, diagError(
+					"Failure when resolving EgressMatrixCell names", err))
+				return diags
+			}
+			if sourceSgtID != "" {
+				request1.EgressMatrixCell.SourceSgtID = sourceSgtID
+			}
+			if destinationSgtID != "" {
+				request1.EgressMatrixCell.DestinationSgtID = destinationSgtID
+			}
+			if sgacls != nil {
+				request1.EgressMatrixCell.Sgacls = sgacls
+			}
+			cell, err := expandEgressMatrixCellCSV(client, "parameters.0", d)
+			if err != nil {
+				diags = append(diags, diagError(
+					"Failure when expanding EgressMatrixCell csv", err))
+				return diags
+			}
+			if cell != nil {
+				request1.EgressMatrixCell.SourceSgtID = cell.sourceSgtID
+				request1.EgressMatrixCell.DestinationSgtID = cell.destinationSgtID
+				request1.EgressMatrixCell.Sgacls = cell.sgacls
+				request1.EgressMatrixCell.DefaultRule = cell.defaultRule
+				request1.EgressMatrixCell.MatrixCellStatus = cell.matrixCellStatus
+			}
+		}
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.EgressMatrixCell.UpdateEgressMatrixCellByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateEgressMatrixCellByID", err, restyResp1.String(),
 					"Failure at UpdateEgressMatrixCellByID, unexpected response", ""))
@@ -485,7 +594,7 @@ func resourceEgressMatrixCellDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.EgressMatrixCell.DeleteEgressMatrixCellByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteEgressMatrixCellByID", err, restyResp1.String(),
 				"Failure at DeleteEgressMatrixCellByID, unexpected response", ""))
@@ -645,3 +754,60 @@ func searchEgressMatrixCellGetEgressMatrixCell(m interface{}, items []isegosdk.R
 	}
 	return foundItem, err
 }
+
+// expandEgressMatrixCellNameReferences returns the ids of the security groups
+// and security group ACLs given by name, empty for the ones given by id.
+func expandEgressMatrixCellNameReferences(client *isegosdk.Client, key string, d *schema.ResourceData) (string, string, []string, error) {
+	sourceSgtID, err := sourceSgtNameReference.expandID(client, key, d)
+	if err != nil {
+		return "", "", nil, err
+	}
+	destinationSgtID, err := destinationSgtNameReference.expandID(client, key, d)
+	if err != nil {
+		return "", "", nil, err
+	}
+	sgacls, err := sgaclNamesReference.expandIDs(client, key, d)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return sourceSgtID, destinationSgtID, sgacls, nil
+}
+
+func flattenEgressMatrixCellNameReferences(client *isegosdk.Client, d *schema.ResourceData, item []map[string]interface{}) {
+	for _, reference := range []nameReference{sourceSgtNameReference, destinationSgtNameReference, sgaclNamesReference} {
+		reference.flatten(client, "parameters.0", d, item)
+	}
+}
+
+// expandEgressMatrixCellCSV returns the cell given as csv with its names
+// resolved to ids, nil when csv is not set.
+func expandEgressMatrixCellCSV(client *isegosdk.Client, key string, d *schema.ResourceData) (*egressMatrixCell, error) {
+	v := interfaceToString(d.Get(key + ".csv"))
+	if v == "" {
+		return nil, nil
+	}
+	cells, _, err := newEgressMatrixResolver(client).csvCells(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(cells) != 1 {
+		return nil, fmt.Errorf("csv must have exactly one cell, got %d", len(cells))
+	}
+	return &cells[0], nil
+}
+
+// flattenEgressMatrixCellCSV sets the csv parameter of item when csv was set,
+// kept as written while it matches the cell on ISE.
+func flattenEgressMatrixCellCSV(m interface{}, d *schema.ResourceData, response *isegosdk.ResponseEgressMatrixCellGetEgressMatrixCellByIDEgressMatrixCell, item []map[string]interface{}) error {
+	prior := interfaceToString(d.Get("parameters.0.csv"))
+	if prior == "" || response == nil || len(item) == 0 {
+		return nil
+	}
+	resolver := newEgressMatrixResolver(m.(ClientConfig).Client)
+	v, err := flattenEgressMatrixCSV(m, resolver, []egressMatrixCell{newEgressMatrixCell(response)}, prior)
+	if err != nil {
+		return err
+	}
+	item[0]["csv"] = v
+	return nil
+}
@@ -2,12 +2,9 @@ package ciscoise
 
 import (
 	"context"
-	"reflect"
-
 	"log"
 
-	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
-
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -16,74 +13,38 @@ func resourceEgressMatrixCellBulkRequest() *schema.Resource {
 	return &schema.Resource{
 		Description: `It performs update operation on EgressMatrixCell.
 - This resource allows the client to submit the bulk request.
+- It waits until the bulk request finishes and stores the status of each resource in item.
 `,
 
 		CreateContext: resourceEgressMatrixCellBulkRequestCreate,
 		ReadContext:   resourceEgressMatrixCellBulkRequestRead,
 		DeleteContext: resourceEgressMatrixCellBulkRequestDelete,
 
-		Schema: map[string]*schema.Schema{
-			"last_updated": &schema.Schema{
-				Description: `Unix timestamp records the last time that the resource was updated.`,
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"item": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"parameters": &schema.Schema{
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
-				MinItems: 1,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"operation_type": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-						"resource_media_type": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-					},
-				},
-			},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(BULK_REQUEST_TIMEOUT),
 		},
+
+		SchemaVersion:  1,
+		StateUpgraders: resourceBulkRequestStateUpgraders(),
+
+		Schema: resourceBulkRequestSchema(resourceBulkRequestParametersElem(resourceEgressMatrixCell(), "csv", "destination_sgt_name", "sgacl_names", "source_sgt_name"), ersBulkOperationTypes),
 	}
 }
 
 func resourceEgressMatrixCellBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning BulkRequestForEgressMatrixCell create")
-	log.Printf("[DEBUG] Missing BulkRequestForEgressMatrixCell create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	var diags diag.Diagnostics
 	request1 := expandRequestEgressMatrixCellBulkRequestBulkRequestForEgressMatrixCell(ctx, "parameters.0", d)
-	response1, err := client.EgressMatrixCell.BulkRequestForEgressMatrixCell(request1)
-	if err != nil || response1 == nil {
-		diags = append(diags, diagErrorWithAlt(
-			"Failure when executing BulkRequestForEgressMatrixCell", err,
-			"Failure at BulkRequestForEgressMatrixCell, unexpected response", ""))
+	diags := executeBulkRequest(ctx, d, m, "BulkRequestForEgressMatrixCell", "/ers/config/egressmatrixcell/bulk/submit", "EgressMatrixCellBulkRequest", request1,
+		func(bulkID string) (interface{}, *resty.Response, error) {
+			return client.EgressMatrixCell.MonitorBulkStatusEgressMatrixCell(bulkID)
+		})
+	if diags.HasError() {
 		return diags
 	}
-
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-	if err := d.Set("item", response1.String()); err != nil {
-		diags = append(diags, diagError(
-			"Failure when setting BulkRequestForEgressMatrixCell response",
-			err))
-		return diags
-	}
-	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-	d.SetId(getUnixTimeString())
-	return resourceEgressMatrixCellBulkRequestRead(ctx, d, m)
+	return append(diags, resourceEgressMatrixCellBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceEgressMatrixCellBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -97,19 +58,14 @@ func resourceEgressMatrixCellBulkRequestDelete(ctx context.Context, d *schema.Re
 	log.Printf("[DEBUG] Missing EgressMatrixCellBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
-func expandRequestEgressMatrixCellBulkRequestBulkRequestForEgressMatrixCell(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestEgressMatrixCellBulkRequestForEgressMatrixCell {
-	request := isegosdk.RequestEgressMatrixCellBulkRequestForEgressMatrixCell{}
-	request.EgressMatrixCellBulkRequest = expandRequestEgressMatrixCellBulkRequestBulkRequestForEgressMatrixCellEgressMatrixCellBulkRequest(ctx, key, d)
-	return &request
-}
 
-func expandRequestEgressMatrixCellBulkRequestBulkRequestForEgressMatrixCellEgressMatrixCellBulkRequest(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestEgressMatrixCellBulkRequestForEgressMatrixCellEgressMatrixCellBulkRequest {
-	request := isegosdk.RequestEgressMatrixCellBulkRequestForEgressMatrixCellEgressMatrixCellBulkRequest{}
-	if v, ok := d.GetOkExists(fixKeyAccess(key + ".operation_type")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".operation_type")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".operation_type")))) {
-		request.OperationType = interfaceToString(v)
-	}
-	if v, ok := d.GetOkExists(fixKeyAccess(key + ".resource_media_type")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".resource_media_type")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".resource_media_type")))) {
-		request.ResourceMediaType = interfaceToString(v)
-	}
-	return &request
+func expandRequestEgressMatrixCellBulkRequestBulkRequestForEgressMatrixCell(ctx context.Context, key string, d *schema.ResourceData) *ersBulkRequest {
+	return expandRequestBulkRequest(ctx, key, d,
+		func(ctx context.Context, key string, d *schema.ResourceData) interface{} {
+			return expandRequestEgressMatrixCellCreateEgressMatrixCell(ctx, key, d)
+		},
+		func(ctx context.Context, key string, d *schema.ResourceData) interface{} {
+			return expandRequestEgressMatrixCellUpdateEgressMatrixCellByID(ctx, key, d)
+		},
+	)
 }
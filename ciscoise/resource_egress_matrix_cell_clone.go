@@ -105,7 +105,7 @@ func resourceEgressMatrixCellCloneCreate(ctx context.Context, d *schema.Resource
 	response1, restyResp1, err := client.EgressMatrixCell.CloneMatrixCell(vvID, vvSrcSgtID, vvDstSgtID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing CloneMatrixCell", err, restyResp1.String(),
 				"Failure at CloneMatrixCell, unexpected response", ""))
@@ -28,7 +28,7 @@ func resourceEndpoint() *schema.Resource {
 		UpdateContext: resourceEndpointUpdate,
 		DeleteContext: resourceEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceEndpointRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -495,10 +495,9 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetEndpointByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -527,10 +526,9 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetEndpointByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -600,7 +598,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		response1, restyResp1, err := client.Endpoint.UpdateEndpointByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateEndpointByID", err, restyResp1.String(),
 					"Failure at UpdateEndpointByID, unexpected response", ""))
@@ -660,7 +658,7 @@ func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, m inter
 	restyResp1, err := client.Endpoint.DeleteEndpointByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteEndpointByID", err, restyResp1.String(),
 				"Failure at DeleteEndpointByID, unexpected response", ""))
@@ -28,7 +28,7 @@ func resourceEndpointGroup() *schema.Resource {
 		UpdateContext: resourceEndpointGroupUpdate,
 		DeleteContext: resourceEndpointGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceEndpointGroupRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -242,10 +242,9 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetEndpointGroupByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -274,10 +273,9 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetEndpointGroupByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -347,7 +345,7 @@ func resourceEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.EndpointIDentityGroup.UpdateEndpointGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateEndpointGroupByID", err, restyResp1.String(),
 					"Failure at UpdateEndpointGroupByID, unexpected response", ""))
@@ -407,7 +405,7 @@ func resourceEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.EndpointIDentityGroup.DeleteEndpointGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteEndpointGroupByID", err, restyResp1.String(),
 				"Failure at DeleteEndpointGroupByID, unexpected response", ""))
@@ -28,7 +28,7 @@ func resourceExternalRadiusServer() *schema.Resource {
 		UpdateContext: resourceExternalRadiusServerUpdate,
 		DeleteContext: resourceExternalRadiusServerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceExternalRadiusServerRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,8 +56,9 @@ func resourceExternalRadiusServer() *schema.Resource {
 						"authenticator_key": &schema.Schema{
 							Description: `The authenticatorKey is required only if enableKeyWrap is true, otherwise it must be ignored or empty.
 The maximum length is 20 ASCII characters or 40 HEXADECIMAL characters (depend on selection in field 'keyInputFormat')`,
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"description": &schema.Schema{
 							Type:     schema.TypeString,
@@ -72,8 +73,9 @@ When running in FIPS mode this option should be enabled for such devices`,
 						"encryption_key": &schema.Schema{
 							Description: `The encryptionKey is required only if enableKeyWrap is true, otherwise it must be ignored or empty.
 The maximum length is 16 ASCII characters or 32 HEXADECIMAL characters (depend on selection in field 'keyInputFormat')`,
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"host_ip": &schema.Schema{
 							Description: `The IP of the host - must be a valid IPV4 address`,
@@ -131,6 +133,7 @@ Allowed Values:
 						"shared_secret": &schema.Schema{
 							Description: `Shared secret maximum length is 128 characters`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 						},
 						"timeout": &schema.Schema{
@@ -167,6 +170,7 @@ Allowed Values:
 							Description: `The authenticatorKey is required only if enableKeyWrap is true, otherwise it must be ignored or empty.
 		The maximum length is 20 ASCII characters or 40 HEXADECIMAL characters (depend on selection in field 'keyInputFormat')`,
 							Type:             schema.TypeString,
+							Sensitive:        true,
 							Optional:         true,
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
@@ -190,6 +194,7 @@ Allowed Values:
 							Description: `The encryptionKey is required only if enableKeyWrap is true, otherwise it must be ignored or empty.
 		The maximum length is 16 ASCII characters or 32 HEXADECIMAL characters (depend on selection in field 'keyInputFormat')`,
 							Type:             schema.TypeString,
+							Sensitive:        true,
 							Optional:         true,
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
@@ -262,6 +267,7 @@ Allowed Values:
 						"shared_secret": &schema.Schema{
 							Description:      `Shared secret maximum length is 128 characters`,
 							Type:             schema.TypeString,
+							Sensitive:        true,
 							Optional:         true,
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
@@ -368,10 +374,9 @@ func resourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetExternalRadiusServerByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -400,10 +405,9 @@ func resourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetExternalRadiusServerByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -473,7 +477,7 @@ func resourceExternalRadiusServerUpdate(ctx context.Context, d *schema.ResourceD
 		response1, restyResp1, err := client.ExternalRadiusServer.UpdateExternalRadiusServerByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateExternalRadiusServerByID", err, restyResp1.String(),
 					"Failure at UpdateExternalRadiusServerByID, unexpected response", ""))
@@ -533,7 +537,7 @@ func resourceExternalRadiusServerDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.ExternalRadiusServer.DeleteExternalRadiusServerByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteExternalRadiusServerByID", err, restyResp1.String(),
 				"Failure at DeleteExternalRadiusServerByID, unexpected response", ""))
@@ -231,10 +231,9 @@ func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetFilterPolicy", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -242,8 +241,7 @@ func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 		items1 := getAllItemsFilterPolicyGetFilterPolicy(m, response1, &queryParams1)
 		item1, _, err := searchFilterPolicyGetFilterPolicy(m, items1, vvSgt, vvSubnet, vvVn, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetFilterPolicy", err, nil)
 		}
 		vItem1 := flattenFilterPolicyGetFilterPolicyByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -267,10 +265,9 @@ func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetFilterPolicyByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -342,7 +339,7 @@ func resourceFilterPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 		response1, restyResp1, err := client.FilterPolicy.UpdateFilterPolicyByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateFilterPolicyByID", err, restyResp1.String(),
 					"Failure at UpdateFilterPolicyByID, unexpected response", ""))
@@ -408,7 +405,7 @@ func resourceFilterPolicyDelete(ctx context.Context, d *schema.ResourceData, m i
 	restyResp1, err := client.FilterPolicy.DeleteFilterPolicyByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteFilterPolicyByID", err, restyResp1.String(),
 				"Failure at DeleteFilterPolicyByID, unexpected response", ""))
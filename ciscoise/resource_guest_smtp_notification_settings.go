@@ -326,10 +326,9 @@ func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.Re
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetGuestSmtpNotificationSettings", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -337,8 +336,7 @@ func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.Re
 		items1 := getAllItemsGuestSmtpNotificationConfigurationGetGuestSmtpNotificationSettings(m, response1, &queryParams1)
 		item1, err := searchGuestSmtpNotificationConfigurationGetGuestSmtpNotificationSettings(m, items1, "", vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetGuestSmtpNotificationSettings", err, nil)
 		}
 		vItem1 := flattenGuestSmtpNotificationConfigurationGetGuestSmtpNotificationSettingsByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -363,10 +361,9 @@ func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.Re
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetGuestSmtpNotificationSettingsByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -421,7 +418,7 @@ func resourceGuestSmtpNotificationSettingsUpdate(ctx context.Context, d *schema.
 		response1, restyResp1, err := client.GuestSmtpNotificationConfiguration.UpdateGuestSmtpNotificationSettingsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateGuestSmtpNotificationSettingsByID", err, restyResp1.String(),
 					"Failure at UpdateGuestSmtpNotificationSettingsByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceGuestSSID() *schema.Resource {
 		UpdateContext: resourceGuestSSIDUpdate,
 		DeleteContext: resourceGuestSSIDDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceGuestSSIDRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -222,10 +222,9 @@ func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetGuestSSID", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -233,8 +232,7 @@ func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interf
 		items1 := getAllItemsGuestSSIDGetGuestSSID(m, response1, &queryParams1)
 		item1, err := searchGuestSSIDGetGuestSSID(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetGuestSSID", err, nil)
 		}
 		vItem1 := flattenGuestSSIDGetGuestSSIDByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -259,10 +257,9 @@ func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetGuestSSIDByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -335,7 +332,7 @@ func resourceGuestSSIDUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		response1, restyResp1, err := client.GuestSSID.UpdateGuestSSIDByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateGuestSSIDByID", err, restyResp1.String(),
 					"Failure at UpdateGuestSSIDByID, unexpected response", ""))
@@ -403,7 +400,7 @@ func resourceGuestSSIDDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.GuestSSID.DeleteGuestSSIDByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteGuestSSIDByID", err, restyResp1.String(),
 				"Failure at DeleteGuestSSIDByID, unexpected response", ""))
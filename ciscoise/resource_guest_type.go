@@ -29,7 +29,7 @@ func resourceGuestType() *schema.Resource {
 		UpdateContext: resourceGuestTypeUpdate,
 		DeleteContext: resourceGuestTypeDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceGuestTypeRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -629,10 +629,9 @@ func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetGuestType", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -640,8 +639,7 @@ func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interf
 		items1 := getAllItemsGuestTypeGetGuestType(m, response1, &queryParams1)
 		item1, err := searchGuestTypeGetGuestType(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetGuestType", err, nil)
 		}
 		vItem1 := flattenGuestTypeGetGuestTypeByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -666,10 +664,9 @@ func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetGuestTypeByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -740,7 +737,7 @@ func resourceGuestTypeUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		response1, restyResp1, err := client.GuestType.UpdateGuestTypeByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateGuestTypeByID", err, restyResp1.String(),
 					"Failure at UpdateGuestTypeByID, unexpected response", ""))
@@ -808,7 +805,7 @@ func resourceGuestTypeDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.GuestType.DeleteGuestTypeByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteGuestTypeByID", err, restyResp1.String(),
 				"Failure at DeleteGuestTypeByID, unexpected response", ""))
@@ -34,7 +34,7 @@ func resourceGuestUser() *schema.Resource {
 		UpdateContext: resourceGuestUserUpdate,
 		DeleteContext: resourceGuestUserDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceGuestUserRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -530,10 +530,9 @@ func resourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetGuestUserByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -562,10 +561,9 @@ func resourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetGuestUserByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -635,7 +633,7 @@ func resourceGuestUserUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		response1, restyResp1, err := client.GuestUser.UpdateGuestUserByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateGuestUserByID", err, restyResp1.String(),
 					"Failure at UpdateGuestUserByID, unexpected response", ""))
@@ -666,7 +664,7 @@ func resourceGuestUserUpdate(ctx context.Context, d *schema.ResourceData, m inte
 									request3.OperationAdditionalData = &operational_data
 									response3, err := client.GuestUser.UpdateGuestUserEmail(vvID, vvPortalID, request3)
 									if err != nil || response3 == nil {
-										log.Printf("[ERROR] response for UpdateGuestUserEmail operation => %v", response3.String())
+										log.Printf("[ERROR] response for UpdateGuestUserEmail operation => %v", redactSecrets(response3.String()))
 									}
 								}
 							}
@@ -724,7 +722,7 @@ func resourceGuestUserDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.GuestUser.DeleteGuestUserByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteGuestUserByID", err, restyResp1.String(),
 				"Failure at DeleteGuestUserByID, unexpected response", ""))
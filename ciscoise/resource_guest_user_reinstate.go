@@ -81,7 +81,7 @@ func resourceGuestUserReinstateCreate(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing ReinstateGuestUserByName", err, response1.String(),
 					"Failure at ReinstateGuestUserByName, unexpected response", ""))
@@ -93,7 +93,7 @@ func resourceGuestUserReinstateCreate(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -119,7 +119,7 @@ func resourceGuestUserReinstateCreate(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response2.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response2.String()))
 
 		if err := d.Set("item", response2.String()); err != nil {
 			diags = append(diags, diagError(
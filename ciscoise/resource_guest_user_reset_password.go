@@ -86,7 +86,7 @@ func resourceGuestUserResetPasswordCreate(ctx context.Context, d *schema.Resourc
 	response1, restyResp1, err := client.GuestUser.ResetGuestUserPasswordByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetGuestUserPasswordByID", err,
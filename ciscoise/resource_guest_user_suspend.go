@@ -105,7 +105,7 @@ func resourceGuestUserSuspendCreate(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SuspendGuestUserByName", err, response1.String(),
 					"Failure at SuspendGuestUserByName, unexpected response", ""))
@@ -117,7 +117,7 @@ func resourceGuestUserSuspendCreate(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -147,7 +147,7 @@ func resourceGuestUserSuspendCreate(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response2.String())
+		log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response2.String()))
 
 		if err := d.Set("item", response2.String()); err != nil {
 			diags = append(diags, diagError(
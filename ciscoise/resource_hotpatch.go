@@ -124,7 +124,7 @@ func resourceHotpatchCreate(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil || response1 == nil {
 		if response1 != nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing InstallPatch", err,
@@ -137,7 +137,7 @@ func resourceHotpatchCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", restyResp1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(restyResp1.String()))
 
 	if response1.Response != nil {
 		taskID := response1.Response.ID
@@ -145,7 +145,7 @@ func resourceHotpatchCreate(ctx context.Context, d *schema.ResourceData, m inter
 			taskResponse, restyResp2, err := client.Tasks.GetTaskStatusByID(taskID)
 			if err != nil || taskResponse == nil {
 				if restyResp2 != nil {
-					log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+					log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 				}
 				diags = append(diags, diagErrorWithAlt(
 					"Failure when executing GetTaskStatusByID", err,
@@ -157,7 +157,7 @@ func resourceHotpatchCreate(ctx context.Context, d *schema.ResourceData, m inter
 				taskResponse, restyResp2, err = client.Tasks.GetTaskStatusByID(taskID)
 				if err != nil || taskResponse == nil {
 					if restyResp2 != nil {
-						log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+						log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 					}
 				}
 			}
@@ -186,16 +186,14 @@ func resourceHotpatchRead(ctx context.Context, d *schema.ResourceData, m interfa
 	response1, restyResp1, err := client.Patching.ListInstalledHotpatches()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
-		d.SetId("")
-		return diags
+		return diagReadError(d, "Failure when executing ListInstalledHotpatches", err, restyResp1)
 	}
 	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
 	item1, err := searchHotPatch(m, response1, vvHotpatchName)
 	if err != nil || item1 == nil {
-		d.SetId("")
-		return diags
+		return diagReadError(d, "Failure when searching ListInstalledHotpatches", err, nil)
 	}
 
 	vItem1 := flattenPatchingListInstalledHotpatchesItems(item1)
@@ -235,7 +233,7 @@ func resourceHotpatchDelete(ctx context.Context, d *schema.ResourceData, m inter
 	getResp1, restyResp1, err := client.Patching.ListInstalledHotpatches()
 	if err != nil || getResp1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		d.SetId("")
 		return diags
@@ -258,7 +256,7 @@ func resourceHotpatchDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing RollbackPatch", err,
@@ -274,7 +272,7 @@ func resourceHotpatchDelete(ctx context.Context, d *schema.ResourceData, m inter
 			taskResponse, restyResp2, err := client.Tasks.GetTaskStatusByID(taskID)
 			if err != nil || taskResponse == nil {
 				if restyResp2 != nil {
-					log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+					log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 				}
 				diags = append(diags, diagErrorWithAlt(
 					"Failure when executing GetTaskStatusByID", err,
@@ -286,7 +284,7 @@ func resourceHotpatchDelete(ctx context.Context, d *schema.ResourceData, m inter
 				taskResponse, restyResp2, err = client.Tasks.GetTaskStatusByID(taskID)
 				if err != nil || taskResponse == nil {
 					if restyResp2 != nil {
-						log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+						log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 					}
 				}
 			}
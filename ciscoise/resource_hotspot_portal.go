@@ -29,7 +29,7 @@ func resourceHotspotPortal() *schema.Resource {
 		UpdateContext: resourceHotspotPortalUpdate,
 		DeleteContext: resourceHotspotPortalDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceHotspotPortalRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1173,10 +1173,9 @@ func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetHotspotPortal", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1184,8 +1183,7 @@ func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m in
 		items1 := getAllItemsHotspotPortalGetHotspotPortal(m, response1, &queryParams1)
 		item1, err := searchHotspotPortalGetHotspotPortal(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetHotspotPortal", err, nil)
 		}
 		vItem1 := flattenHotspotPortalGetHotspotPortalByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -1210,10 +1208,9 @@ func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetHotspotPortalByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1284,7 +1281,7 @@ func resourceHotspotPortalUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.HotspotPortal.UpdateHotspotPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateHotspotPortalByID", err, restyResp1.String(),
 					"Failure at UpdateHotspotPortalByID, unexpected response", ""))
@@ -1352,7 +1349,7 @@ func resourceHotspotPortalDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.HotspotPortal.DeleteHotspotPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteHotspotPortalByID", err, restyResp1.String(),
 				"Failure at DeleteHotspotPortalByID, unexpected response", ""))
@@ -30,7 +30,7 @@ Partial update is not supported
 		UpdateContext: resourceIDStoreSequenceUpdate,
 		DeleteContext: resourceIDStoreSequenceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceIDStoreSequenceRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -294,10 +294,9 @@ func resourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetIDentitySequenceByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -326,10 +325,9 @@ func resourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetIDentitySequenceByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -399,7 +397,7 @@ func resourceIDStoreSequenceUpdate(ctx context.Context, d *schema.ResourceData,
 		response1, restyResp1, err := client.IDentitySequence.UpdateIDentitySequenceByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateIDentitySequenceByID", err, restyResp1.String(),
 					"Failure at UpdateIDentitySequenceByID, unexpected response", ""))
@@ -459,7 +457,7 @@ func resourceIDStoreSequenceDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.IDentitySequence.DeleteIDentitySequenceByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteIDentitySequenceByID", err, restyResp1.String(),
 				"Failure at DeleteIDentitySequenceByID, unexpected response", ""))
@@ -28,7 +28,7 @@ func resourceIDentityGroup() *schema.Resource {
 		UpdateContext: resourceIDentityGroupUpdate,
 		DeleteContext: resourceIDentityGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceIDentityGroupRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -231,10 +231,9 @@ func resourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetIDentityGroupByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -263,10 +262,9 @@ func resourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetIDentityGroupByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -336,7 +334,7 @@ func resourceIDentityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.IDentityGroups.UpdateIDentityGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateIDentityGroupByID", err, restyResp1.String(),
 					"Failure at UpdateIDentityGroupByID, unexpected response", ""))
@@ -396,7 +394,7 @@ func resourceIDentityGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.IDentityGroups.DeleteIDentityGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteIDentityGroupByID", err, restyResp1.String(),
 				"Failure at DeleteIDentityGroupByID, unexpected response", ""))
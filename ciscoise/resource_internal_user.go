@@ -32,7 +32,7 @@ func resourceInternalUser() *schema.Resource {
 		UpdateContext: resourceInternalUserUpdate,
 		DeleteContext: resourceInternalUserDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceInternalUserRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,8 +65,9 @@ func resourceInternalUser() *schema.Resource {
 							Computed: true,
 						},
 						"enable_password": &schema.Schema{
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Sensitive: true,
+							Computed:  true,
 						},
 						"enabled": &schema.Schema{
 							Description: `Whether the user is enabled/disabled. To use it as filter, the values should be 'Enabled' or 'Disabled'.
@@ -177,6 +178,7 @@ The values are case sensitive. For example, '[ERSObjectURL]?filter=enabled.EQ.En
 						},
 						"enable_password": &schema.Schema{
 							Type:             schema.TypeString,
+							Sensitive:        true,
 							Optional:         true,
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
@@ -366,10 +368,9 @@ func resourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetInternalUserByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -398,10 +399,9 @@ func resourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetInternalUserByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -471,7 +471,7 @@ func resourceInternalUserUpdate(ctx context.Context, d *schema.ResourceData, m i
 		response1, restyResp1, err := client.InternalUser.UpdateInternalUserByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateInternalUserByID", err, restyResp1.String(),
 					"Failure at UpdateInternalUserByID, unexpected response", ""))
@@ -531,7 +531,7 @@ func resourceInternalUserDelete(ctx context.Context, d *schema.ResourceData, m i
 	restyResp1, err := client.InternalUser.DeleteInternalUserByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteInternalUserByID", err, restyResp1.String(),
 				"Failure at DeleteInternalUserByID, unexpected response", ""))
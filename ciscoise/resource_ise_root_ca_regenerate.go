@@ -106,7 +106,7 @@ func resourceIseRootCaRegenerateCreate(ctx context.Context, d *schema.ResourceDa
 	response1, restyResp1, err := client.Certificates.RegenerateIseRootCa(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing RegenerateIseRootCa", err, restyResp1.String(),
 				"Failure at RegenerateIseRootCa, unexpected response", ""))
@@ -158,10 +158,9 @@ func resourceLicensingRegistrationRead(ctx context.Context, d *schema.ResourceDa
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
-		d.SetId("")
-		return diags
+		return diagReadError(d, "Failure when executing GetRegistrationInfo", err, restyResp1)
 	}
 
 	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -168,18 +168,16 @@ func resourceLicensingTierStateRead(ctx context.Context, d *schema.ResourceData,
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
-		d.SetId("")
-		return diags
+		return diagReadError(d, "Failure when executing GetTierStateInfo", err, restyResp1)
 	}
 
 	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
 
 	response_nodes, err := searchLicensingGetTierStateInfo(m, response1, vName)
 	if err != nil || response_nodes == nil || len(*response_nodes) == 0 {
-		d.SetId("")
-		return diags
+		return diagReadError(d, "Failure when searching GetTierStateInfo", err, nil)
 	}
 
 	vItem1 := flattenLicensingGetTierStateInfoItems(response1)
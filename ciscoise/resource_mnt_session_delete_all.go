@@ -53,7 +53,7 @@ func resourceMntSessionDeleteAllCreate(ctx context.Context, d *schema.ResourceDa
 	response1, err := client.Misc.DeleteAllSessions()
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteAllSessions", err, response1.String(),
 				"Failure at DeleteAllSessions, unexpected response", ""))
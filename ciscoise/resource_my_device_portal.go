@@ -29,7 +29,7 @@ func resourceMyDevicePortal() *schema.Resource {
 		UpdateContext: resourceMyDevicePortalUpdate,
 		DeleteContext: resourceMyDevicePortalDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceMyDevicePortalRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1254,10 +1254,9 @@ func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetMyDevicePortal", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1265,8 +1264,7 @@ func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m i
 		items1 := getAllItemsMyDevicePortalGetMyDevicePortal(m, response1, &queryParams1)
 		item1, err := searchMyDevicePortalGetMyDevicePortal(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetMyDevicePortal", err, nil)
 		}
 		vItem1 := flattenMyDevicePortalGetMyDevicePortalByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -1291,10 +1289,9 @@ func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetMyDevicePortalByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1367,7 +1364,7 @@ func resourceMyDevicePortalUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.MyDevicePortal.UpdateMyDevicePortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateMyDevicePortalByID", err, restyResp1.String(),
 					"Failure at UpdateMyDevicePortalByID, unexpected response", ""))
@@ -1436,7 +1433,7 @@ func resourceMyDevicePortalDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.MyDevicePortal.DeleteMyDevicePortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteMyDevicePortalByID", err, restyResp1.String(),
 				"Failure at DeleteMyDevicePortalByID, unexpected response", ""))
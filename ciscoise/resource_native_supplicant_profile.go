@@ -27,7 +27,7 @@ func resourceNativeSupplicantProfile() *schema.Resource {
 		UpdateContext: resourceNativeSupplicantProfileUpdate,
 		DeleteContext: resourceNativeSupplicantProfileDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceNativeSupplicantProfileRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -234,7 +234,7 @@ func resourceNativeSupplicantProfileCreate(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.NativeSupplicantProfile.UpdateNativeSupplicantProfileByID(vvID, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateNativeSupplicantProfileByID", err, restyResp1.String(),
 				"Failure at UpdateNativeSupplicantProfileByID, unexpected response", ""))
@@ -279,10 +279,9 @@ func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNativeSupplicantProfile", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -290,8 +289,7 @@ func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resource
 		items1 := getAllItemsNativeSupplicantProfileGetNativeSupplicantProfile(m, response1, &queryParams1)
 		item1, err := searchNativeSupplicantProfileGetNativeSupplicantProfile(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNativeSupplicantProfile", err, nil)
 		}
 		vItem1 := flattenNativeSupplicantProfileGetNativeSupplicantProfileByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -316,10 +314,9 @@ func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNativeSupplicantProfileByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -391,7 +388,7 @@ func resourceNativeSupplicantProfileUpdate(ctx context.Context, d *schema.Resour
 		response1, restyResp1, err := client.NativeSupplicantProfile.UpdateNativeSupplicantProfileByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNativeSupplicantProfileByID", err, restyResp1.String(),
 					"Failure at UpdateNativeSupplicantProfileByID, unexpected response", ""))
@@ -459,7 +456,7 @@ func resourceNativeSupplicantProfileDelete(ctx context.Context, d *schema.Resour
 	restyResp1, err := client.NativeSupplicantProfile.DeleteNativeSupplicantProfileByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNativeSupplicantProfileByID", err, restyResp1.String(),
 				"Failure at DeleteNativeSupplicantProfileByID, unexpected response", ""))
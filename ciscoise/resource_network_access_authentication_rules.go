@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,7 +43,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceNetworkAccessAuthenticationRulesUpdate,
 		DeleteContext: resourceNetworkAccessAuthenticationRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateRuleByIDOrName(resourceNetworkAccessAuthenticationRulesRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -910,6 +911,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": resourceConditionExpressionSchema(),
+									"condition_name":       resourceConditionNameSchema(),
+									"condition_json":       resourceConditionJSONSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -979,6 +983,8 @@ func resourceNetworkAccessAuthenticationRulesCreate(ctx context.Context, d *sche
 
 	vPolicyID, okPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvName string
 	if !okID || vID == "" {
@@ -1022,7 +1028,20 @@ func resourceNetworkAccessAuthenticationRulesCreate(ctx context.Context, d *sche
 			}
 		}
 	}
-	resp1, restyResp1, err := client.NetworkAccessAuthenticationRules.CreateNetworkAccessAuthenticationRule(vvPolicyID, request1)
+	var resp1 *isegosdk.ResponseNetworkAccessAuthenticationRulesCreateNetworkAccessAuthenticationRule
+	var restyResp1 *resty.Response
+	body, ok, err := ruleConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateNetworkAccessAuthenticationRule", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseNetworkAccessAuthenticationRulesCreateNetworkAccessAuthenticationRule{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateNetworkAccessAuthenticationRule", resty.MethodPost, "/api/v1/policy/network-access/policy-set/"+vvPolicyID+"/authentication", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.NetworkAccessAuthenticationRules.CreateNetworkAccessAuthenticationRule(vvPolicyID, request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -1087,10 +1106,9 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessAuthenticationRules", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1098,8 +1116,7 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 		items1 := getAllItemsNetworkAccessAuthenticationRulesGetNetworkAccessAuthenticationRules(m, response1, vvPolicyID)
 		item1, err := searchNetworkAccessAuthenticationRulesGetNetworkAccessAuthenticationRules(m, items1, vvName, vvID, vvPolicyID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessAuthenticationRules", err, nil)
 		}
 		vItem1 := flattenNetworkAccessAuthenticationRulesGetNetworkAccessAuthenticationRuleByIDItem(item1, vID, vPolicyID)
 		if err := d.Set("item", vItem1); err != nil {
@@ -1109,6 +1126,9 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 			return diags
 		}
 		log.Printf("[DEBUG] Parameters %+v", responseInterfaceToString(d.Get("parameters")))
+		if item1.Rule != nil {
+			ruleConditionJSON.flatten(d, vItem1, restyResp1, item1.Rule.ID)
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessAuthenticationRules search response",
@@ -1123,10 +1143,9 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessAuthenticationRuleByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1138,6 +1157,7 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
+		ruleConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessAuthenticationRuleByID response",
@@ -1177,6 +1197,8 @@ func resourceNetworkAccessAuthenticationRulesUpdate(ctx context.Context, d *sche
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -1208,10 +1230,23 @@ func resourceNetworkAccessAuthenticationRulesUpdate(ctx context.Context, d *sche
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.NetworkAccessAuthenticationRules.UpdateNetworkAccessAuthenticationRuleByID(vvPolicyID, vvID, request1)
+		var response1 *isegosdk.ResponseNetworkAccessAuthenticationRulesUpdateNetworkAccessAuthenticationRuleByID
+		var restyResp1 *resty.Response
+		body, ok, err := ruleConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateNetworkAccessAuthenticationRuleByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseNetworkAccessAuthenticationRulesUpdateNetworkAccessAuthenticationRuleByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateNetworkAccessAuthenticationRuleByID", resty.MethodPut, "/api/v1/policy/network-access/policy-set/"+vvPolicyID+"/authentication/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.NetworkAccessAuthenticationRules.UpdateNetworkAccessAuthenticationRuleByID(vvPolicyID, vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessAuthenticationRuleByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessAuthenticationRuleByID, unexpected response", ""))
@@ -1255,6 +1290,8 @@ func resourceNetworkAccessAuthenticationRulesDelete(ctx context.Context, d *sche
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -1293,7 +1330,7 @@ func resourceNetworkAccessAuthenticationRulesDelete(ctx context.Context, d *sche
 	response1, restyResp1, err := client.NetworkAccessAuthenticationRules.DeleteNetworkAccessAuthenticationRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessAuthenticationRuleByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessAuthenticationRuleByID, unexpected response", ""))
@@ -0,0 +1,68 @@
+package ciscoise
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+func networkAccessAuthorizationRuleList() ruleList {
+	parameters := resourceNetworkAccessAuthorizationRules().Schema["parameters"].Elem.(*schema.Resource).Schema
+	return ruleList{
+		operation:  "NetworkAccessAuthorizationRule",
+		path:       "/api/v1/policy/network-access/policy-set/%s/authorization",
+		conditions: ruleConditionJSON,
+		results: []ruleListResult{
+			{key: "profile", apiKey: "profile", schema: parameters["profile"]},
+			{key: "security_group", apiKey: "securityGroup", schema: parameters["security_group"]},
+		},
+		list: func(client *isegosdk.Client, policyID string) (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRules(policyID)
+			return restyResp, err
+		},
+		delete: func(client *isegosdk.Client, policyID string, id string) (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessAuthorizationRules.DeleteNetworkAccessAuthorizationRuleByID(policyID, id)
+			return restyResp, err
+		},
+	}
+}
+
+func resourceNetworkAccessAuthorizationRuleList() *schema.Resource {
+	return &schema.Resource{
+		Description: `It manages create, read, update, move and delete operations on Network Access - Authorization Rules.
+- Manages the ordered list of authorization rules of a network access policy set. The rank of each rule is its position in the list.
+- Only the rules whose attributes or rank differ are updated, so ranks do not collide as they do with one ciscoise_network_access_authorization_rules resource per rule.
+- Rules of the policy set that are not in the list are reported in item.unmanaged_rules and kept below the listed rules. The default rule is never managed.
+`,
+
+		CreateContext: resourceNetworkAccessAuthorizationRuleListCreate,
+		ReadContext:   resourceNetworkAccessAuthorizationRuleListRead,
+		UpdateContext: resourceNetworkAccessAuthorizationRuleListUpdate,
+		DeleteContext: resourceNetworkAccessAuthorizationRuleListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: networkAccessAuthorizationRuleList().importState,
+		},
+
+		Schema: networkAccessAuthorizationRuleList().resourceSchema(),
+	}
+}
+
+func resourceNetworkAccessAuthorizationRuleListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return networkAccessAuthorizationRuleList().create(ctx, d, m)
+}
+
+func resourceNetworkAccessAuthorizationRuleListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return networkAccessAuthorizationRuleList().read(ctx, d, m)
+}
+
+func resourceNetworkAccessAuthorizationRuleListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return networkAccessAuthorizationRuleList().update(ctx, d, m)
+}
+
+func resourceNetworkAccessAuthorizationRuleListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return networkAccessAuthorizationRuleList().remove(ctx, d, m)
+}
@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,7 +43,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceNetworkAccessAuthorizationRulesUpdate,
 		DeleteContext: resourceNetworkAccessAuthorizationRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateRuleByIDOrName(resourceNetworkAccessAuthorizationRulesRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -878,6 +879,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": resourceConditionExpressionSchema(),
+									"condition_name":       resourceConditionNameSchema(),
+									"condition_json":       resourceConditionJSONSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -954,6 +958,8 @@ func resourceNetworkAccessAuthorizationRulesCreate(ctx context.Context, d *schem
 
 	vPolicyID, okPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvName string
 	if !okID || vID == "" {
@@ -998,7 +1004,20 @@ func resourceNetworkAccessAuthorizationRulesCreate(ctx context.Context, d *schem
 			}
 		}
 	}
-	resp1, restyResp1, err := client.NetworkAccessAuthorizationRules.CreateNetworkAccessAuthorizationRule(vvPolicyID, request1)
+	var resp1 *isegosdk.ResponseNetworkAccessAuthorizationRulesCreateNetworkAccessAuthorizationRule
+	var restyResp1 *resty.Response
+	body, ok, err := ruleConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateNetworkAccessAuthorizationRule", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseNetworkAccessAuthorizationRulesCreateNetworkAccessAuthorizationRule{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateNetworkAccessAuthorizationRule", resty.MethodPost, "/api/v1/policy/network-access/policy-set/"+vvPolicyID+"/authorization", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.NetworkAccessAuthorizationRules.CreateNetworkAccessAuthorizationRule(vvPolicyID, request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -1066,10 +1085,9 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessAuthorizationRules", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1077,8 +1095,7 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 		items1 := getAllItemsNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRules(m, response1, vvPolicyID)
 		item1, err := searchNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRules(m, items1, vvName, vvID, vvPolicyID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessAuthorizationRules", err, nil)
 		}
 		vItem1 := flattenNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRuleByIDItem(item1)
 
@@ -1091,6 +1108,9 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 			return diags
 		}
 		vItem1[0]["policy_id"] = vvPolicyID
+		if item1.Rule != nil {
+			ruleConditionJSON.flatten(d, vItem1, restyResp1, item1.Rule.ID)
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessAuthorizationRules search response",
@@ -1106,10 +1126,9 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessAuthorizationRuleByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1123,6 +1142,7 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 		}
 		vItem2[0]["policy_id"] = vvPolicyID
 		vItem2[0]["id"] = vvID
+		ruleConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessAuthorizationRuleByID response",
@@ -1164,6 +1184,8 @@ func resourceNetworkAccessAuthorizationRulesUpdate(ctx context.Context, d *schem
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -1195,10 +1217,23 @@ func resourceNetworkAccessAuthorizationRulesUpdate(ctx context.Context, d *schem
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.NetworkAccessAuthorizationRules.UpdateNetworkAccessAuthorizationRuleByID(vvPolicyID, vvID, request1)
+		var response1 *isegosdk.ResponseNetworkAccessAuthorizationRulesUpdateNetworkAccessAuthorizationRuleByID
+		var restyResp1 *resty.Response
+		body, ok, err := ruleConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateNetworkAccessAuthorizationRuleByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseNetworkAccessAuthorizationRulesUpdateNetworkAccessAuthorizationRuleByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateNetworkAccessAuthorizationRuleByID", resty.MethodPut, "/api/v1/policy/network-access/policy-set/"+vvPolicyID+"/authorization/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.NetworkAccessAuthorizationRules.UpdateNetworkAccessAuthorizationRuleByID(vvPolicyID, vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessAuthorizationRuleByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessAuthorizationRuleByID, unexpected response", ""))
@@ -1244,6 +1279,8 @@ func resourceNetworkAccessAuthorizationRulesDelete(ctx context.Context, d *schem
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -1282,7 +1319,7 @@ func resourceNetworkAccessAuthorizationRulesDelete(ctx context.Context, d *schem
 	response1, restyResp1, err := client.NetworkAccessAuthorizationRules.DeleteNetworkAccessAuthorizationRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessAuthorizationRuleByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessAuthorizationRuleByID, unexpected response", ""))
@@ -965,7 +965,7 @@ func resourceNetworkAccessAuthorizationRulesUpdateUpdateCreate(ctx context.Conte
 			log.Printf("[DEBUG] Retrieved error response %s", err.Error())
 		}
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			err = fmt.Errorf(restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
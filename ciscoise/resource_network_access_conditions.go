@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -73,7 +74,7 @@ TimeAndDate
 		UpdateContext: resourceNetworkAccessConditionsUpdate,
 		DeleteContext: resourceNetworkAccessConditionsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceNetworkAccessConditionsRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -473,6 +474,7 @@ TimeAndDate
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
 						},
+						"condition_json": resourceConditionJSONSchema(),
 						"dates_range": &schema.Schema{
 							Description:      `<p>Defines for which date/s TimeAndDate condition will be matched<br> Options are - Date range, for specific date, the same date should be used for start/end date <br> Default - no specific dates<br> In order to reset the dates to have no specific dates Date format - yyyy-mm-dd (MM = month, dd = day, yyyy = year)</p>`,
 							Type:             schema.TypeList,
@@ -688,7 +690,20 @@ func resourceNetworkAccessConditionsCreate(ctx context.Context, d *schema.Resour
 			}
 		}
 	}
-	resp1, restyResp1, err := client.NetworkAccessConditions.CreateNetworkAccessCondition(request1)
+	var resp1 *isegosdk.ResponseNetworkAccessConditionsCreateNetworkAccessCondition
+	var restyResp1 *resty.Response
+	body, ok, err := libraryConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateNetworkAccessCondition", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseNetworkAccessConditionsCreateNetworkAccessCondition{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateNetworkAccessCondition", resty.MethodPost, "/api/v1/policy/network-access/condition", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.NetworkAccessConditions.CreateNetworkAccessCondition(request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -738,10 +753,9 @@ func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessConditionByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -753,6 +767,7 @@ func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
+		libraryConditionJSON.flatten(d, vItemName1, restyResp1, vID)
 		if err := d.Set("parameters", vItemName1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessConditionByName response",
@@ -770,10 +785,9 @@ func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessConditionByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -785,6 +799,7 @@ func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
+		libraryConditionJSON.flatten(d, vItemID2, restyResp2, vvID)
 		if err := d.Set("parameters", vItemID2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessConditionByID response",
@@ -840,10 +855,23 @@ func resourceNetworkAccessConditionsUpdate(ctx context.Context, d *schema.Resour
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.NetworkAccessConditions.UpdateNetworkAccessConditionByID(vvID, request1)
+		var response1 *isegosdk.ResponseNetworkAccessConditionsUpdateNetworkAccessConditionByID
+		var restyResp1 *resty.Response
+		body, ok, err := libraryConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateNetworkAccessConditionByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseNetworkAccessConditionsUpdateNetworkAccessConditionByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateNetworkAccessConditionByID", resty.MethodPut, "/api/v1/policy/network-access/condition/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.NetworkAccessConditions.UpdateNetworkAccessConditionByID(vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessConditionByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessConditionByID, unexpected response", ""))
@@ -903,7 +931,7 @@ func resourceNetworkAccessConditionsDelete(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.NetworkAccessConditions.DeleteNetworkAccessConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessConditionByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessConditionByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceNetworkAccessDictionary() *schema.Resource {
 		UpdateContext: resourceNetworkAccessDictionaryUpdate,
 		DeleteContext: resourceNetworkAccessDictionaryDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceNetworkAccessDictionaryRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -254,10 +254,9 @@ func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessDictionaries", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -265,8 +264,7 @@ func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resource
 		items1 := getAllItemsNetworkAccessDictionaryGetNetworkAccessDictionaries(m, response1)
 		item1, err := searchNetworkAccessDictionaryGetNetworkAccessDictionaries(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessDictionaries", err, nil)
 		}
 		vItem1 := flattenNetworkAccessDictionaryGetNetworkAccessDictionaryByNameItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -291,10 +289,9 @@ func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessDictionaryByName", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -364,7 +361,7 @@ func resourceNetworkAccessDictionaryUpdate(ctx context.Context, d *schema.Resour
 		response1, restyResp1, err := client.NetworkAccessDictionary.UpdateNetworkAccessDictionaryByName(vvName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessDictionaryByName", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessDictionaryByName, unexpected response", ""))
@@ -431,7 +428,7 @@ func resourceNetworkAccessDictionaryDelete(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.NetworkAccessDictionary.DeleteNetworkAccessDictionaryByName(vvName)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessDictionaryByName", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessDictionaryByName, unexpected response", ""))
@@ -288,10 +288,9 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessDictionaryAttributesByDictionaryName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -299,8 +298,7 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 		items1 := getAllItemsNetworkAccessDictionaryAttributeGetNetworkAccessDictionaryAttributesByDictionaryName(m, response1, vvDictionaryName)
 		item1, err := searchNetworkAccessDictionaryAttributeGetNetworkAccessDictionaryAttributesByDictionaryName(m, items1, vvName, vvDictionaryName)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessDictionaryAttributesByDictionaryName", err, nil)
 		}
 		vItem1 := flattenNetworkAccessDictionaryAttributeGetNetworkAccessDictionaryAttributeByNameItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -326,10 +324,9 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessDictionaryAttributeByName", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -403,7 +400,7 @@ func resourceNetworkAccessDictionaryAttributeUpdate(ctx context.Context, d *sche
 		response1, restyResp1, err := client.NetworkAccessDictionaryAttribute.UpdateNetworkAccessDictionaryAttributeByName(vvName, vvDictionaryName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessDictionaryAttributeByName", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessDictionaryAttributeByName, unexpected response", ""))
@@ -473,7 +470,7 @@ func resourceNetworkAccessDictionaryAttributeDelete(ctx context.Context, d *sche
 	response1, restyResp1, err := client.NetworkAccessDictionaryAttribute.DeleteNetworkAccessDictionaryAttributeByName(vvName, vvDictionaryName)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessDictionaryAttributeByName", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessDictionaryAttributeByName, unexpected response", ""))
@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -43,7 +44,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceNetworkAccessGlobalExceptionRulesUpdate,
 		DeleteContext: resourceNetworkAccessGlobalExceptionRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateGlobalRuleByIDOrName(resourceNetworkAccessGlobalExceptionRulesRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -539,6 +540,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": resourceConditionExpressionSchema(),
+									"condition_name":       resourceConditionNameSchema(),
+									"condition_json":       resourceConditionJSONSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -606,6 +610,8 @@ func resourceNetworkAccessGlobalExceptionRulesCreate(ctx context.Context, d *sch
 
 	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
+	policySetMutexKV.Lock(networkAccessGlobalExceptionRulesKey)
+	defer policySetMutexKV.Unlock(networkAccessGlobalExceptionRulesKey)
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessGlobalExceptionRulesCreateNetworkAccessPolicySetGlobalExceptionRule(ctx, "parameters.0", d)
@@ -654,7 +660,20 @@ func resourceNetworkAccessGlobalExceptionRulesCreate(ctx context.Context, d *sch
 			}
 		}
 	}
-	resp1, restyResp1, err := client.NetworkAccessAuthorizationGlobalExceptionRules.CreateNetworkAccessPolicySetGlobalExceptionRule(request1)
+	var resp1 *isegosdk.ResponseNetworkAccessAuthorizationGlobalExceptionRulesCreateNetworkAccessPolicySetGlobalExceptionRule
+	var restyResp1 *resty.Response
+	body, ok, err := ruleConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateNetworkAccessPolicySetGlobalExceptionRule", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseNetworkAccessAuthorizationGlobalExceptionRulesCreateNetworkAccessPolicySetGlobalExceptionRule{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateNetworkAccessPolicySetGlobalExceptionRule", resty.MethodPost, "/api/v1/policy/network-access/policy-set/global-exception", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.NetworkAccessAuthorizationGlobalExceptionRules.CreateNetworkAccessPolicySetGlobalExceptionRule(request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -719,10 +738,9 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessPolicySetGlobalExceptionRules", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -730,8 +748,7 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 		items1 := getAllItemsNetworkAccessAuthorizationGlobalExceptionRulesGetNetworkAccessPolicySetGlobalExceptionRules(m, response1)
 		item1, err := searchNetworkAccessAuthorizationGlobalExceptionRulesGetNetworkAccessPolicySetGlobalExceptionRules(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessPolicySetGlobalExceptionRules", err, nil)
 		}
 		vItem1 := flattenNetworkAccessAuthorizationGlobalExceptionRulesGetNetworkAccessPolicySetGlobalExceptionRuleByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -740,6 +757,9 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 				err))
 			return diags
 		}
+		if item1.Rule != nil {
+			ruleConditionJSON.flatten(d, vItem1, restyResp1, item1.Rule.ID)
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessPolicySetGlobalExceptionRules search response",
@@ -755,10 +775,9 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessPolicySetGlobalExceptionRuleByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -770,6 +789,7 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 				err))
 			return diags
 		}
+		ruleConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessPolicySetGlobalExceptionRuleByID response",
@@ -788,6 +808,8 @@ func resourceNetworkAccessGlobalExceptionRulesUpdate(ctx context.Context, d *sch
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
+	policySetMutexKV.Lock(networkAccessGlobalExceptionRulesKey)
+	defer policySetMutexKV.Unlock(networkAccessGlobalExceptionRulesKey)
 
 	resourceID := d.Id()
 	resourceMap := separateResourceID(resourceID)
@@ -840,10 +862,23 @@ func resourceNetworkAccessGlobalExceptionRulesUpdate(ctx context.Context, d *sch
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.NetworkAccessAuthorizationGlobalExceptionRules.UpdateNetworkAccessPolicySetGlobalExceptionRuleByID(vvID, request1)
+		var response1 *isegosdk.ResponseNetworkAccessAuthorizationGlobalExceptionRulesUpdateNetworkAccessPolicySetGlobalExceptionRuleByID
+		var restyResp1 *resty.Response
+		body, ok, err := ruleConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateNetworkAccessPolicySetGlobalExceptionRuleByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseNetworkAccessAuthorizationGlobalExceptionRulesUpdateNetworkAccessPolicySetGlobalExceptionRuleByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateNetworkAccessPolicySetGlobalExceptionRuleByID", resty.MethodPut, "/api/v1/policy/network-access/policy-set/global-exception/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.NetworkAccessAuthorizationGlobalExceptionRules.UpdateNetworkAccessPolicySetGlobalExceptionRuleByID(vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessPolicySetGlobalExceptionRuleByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessPolicySetGlobalExceptionRuleByID, unexpected response", ""))
@@ -866,6 +901,8 @@ func resourceNetworkAccessGlobalExceptionRulesDelete(ctx context.Context, d *sch
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
+	policySetMutexKV.Lock(networkAccessGlobalExceptionRulesKey)
+	defer policySetMutexKV.Unlock(networkAccessGlobalExceptionRulesKey)
 
 	resourceID := d.Id()
 	resourceMap := separateResourceID(resourceID)
@@ -910,7 +947,7 @@ func resourceNetworkAccessGlobalExceptionRulesDelete(ctx context.Context, d *sch
 	response1, restyResp1, err := client.NetworkAccessAuthorizationGlobalExceptionRules.DeleteNetworkAccessPolicySetGlobalExceptionRuleByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessPolicySetGlobalExceptionRuleByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessPolicySetGlobalExceptionRuleByID, unexpected response", ""))
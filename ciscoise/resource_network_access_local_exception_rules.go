@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,7 +43,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		UpdateContext: resourceNetworkAccessLocalExceptionRulesUpdate,
 		DeleteContext: resourceNetworkAccessLocalExceptionRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateRuleByIDOrName(resourceNetworkAccessLocalExceptionRulesRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -543,6 +544,9 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": resourceConditionExpressionSchema(),
+									"condition_name":       resourceConditionNameSchema(),
+									"condition_json":       resourceConditionJSONSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -619,6 +623,8 @@ func resourceNetworkAccessLocalExceptionRulesCreate(ctx context.Context, d *sche
 
 	vPolicyID, okPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvName string
 	if !okID || vID == "" {
@@ -663,7 +669,20 @@ func resourceNetworkAccessLocalExceptionRulesCreate(ctx context.Context, d *sche
 			}
 		}
 	}
-	resp1, restyResp1, err := client.NetworkAccessAuthorizationExceptionRules.CreateNetworkAccessLocalExceptionRule(vvPolicyID, request1)
+	var resp1 *isegosdk.ResponseNetworkAccessAuthorizationExceptionRulesCreateNetworkAccessLocalExceptionRule
+	var restyResp1 *resty.Response
+	body, ok, err := ruleConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateNetworkAccessLocalExceptionRule", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseNetworkAccessAuthorizationExceptionRulesCreateNetworkAccessLocalExceptionRule{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateNetworkAccessLocalExceptionRule", resty.MethodPost, "/api/v1/policy/network-access/policy-set/"+vvPolicyID+"/exception", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.NetworkAccessAuthorizationExceptionRules.CreateNetworkAccessLocalExceptionRule(vvPolicyID, request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -731,10 +750,9 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessLocalExceptionRules", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -742,8 +760,7 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 		items1 := getAllItemsNetworkAccessAuthorizationExceptionRulesGetNetworkAccessLocalExceptionRules(m, response1, vvPolicyID)
 		item1, err := searchNetworkAccessAuthorizationExceptionRulesGetNetworkAccessLocalExceptionRules(m, items1, vvName, vvID, vvPolicyID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessLocalExceptionRules", err, nil)
 		}
 		vItem1 := flattenNetworkAccessAuthorizationExceptionRulesGetNetworkAccessLocalExceptionRuleByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -752,6 +769,9 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
+		if item1.Rule != nil {
+			ruleConditionJSON.flatten(d, vItem1, restyResp1, item1.Rule.ID)
+		}
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessLocalExceptionRules search response",
@@ -767,10 +787,9 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessLocalExceptionRuleByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -782,6 +801,7 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
+		ruleConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessLocalExceptionRuleByID response",
@@ -823,6 +843,8 @@ func resourceNetworkAccessLocalExceptionRulesUpdate(ctx context.Context, d *sche
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -854,10 +876,23 @@ func resourceNetworkAccessLocalExceptionRulesUpdate(ctx context.Context, d *sche
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.NetworkAccessAuthorizationExceptionRules.UpdateNetworkAccessLocalExceptionRuleByID(vvPolicyID, vvID, request1)
+		var response1 *isegosdk.ResponseNetworkAccessAuthorizationExceptionRulesUpdateNetworkAccessLocalExceptionRuleByID
+		var restyResp1 *resty.Response
+		body, ok, err := ruleConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateNetworkAccessLocalExceptionRuleByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseNetworkAccessAuthorizationExceptionRulesUpdateNetworkAccessLocalExceptionRuleByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateNetworkAccessLocalExceptionRuleByID", resty.MethodPut, "/api/v1/policy/network-access/policy-set/"+vvPolicyID+"/exception/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.NetworkAccessAuthorizationExceptionRules.UpdateNetworkAccessLocalExceptionRuleByID(vvPolicyID, vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessLocalExceptionRuleByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessLocalExceptionRuleByID, unexpected response", ""))
@@ -903,6 +938,8 @@ func resourceNetworkAccessLocalExceptionRulesDelete(ctx context.Context, d *sche
 	}
 	vvID := vID
 	vvPolicyID := vPolicyID
+	policySetMutexKV.Lock(vvPolicyID)
+	defer policySetMutexKV.Unlock(vvPolicyID)
 	vvName := vName
 	method1 := []bool{okID}
 	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
@@ -941,7 +978,7 @@ func resourceNetworkAccessLocalExceptionRulesDelete(ctx context.Context, d *sche
 	response1, restyResp1, err := client.NetworkAccessAuthorizationExceptionRules.DeleteNetworkAccessLocalExceptionRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessLocalExceptionRuleByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessLocalExceptionRuleByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceNetworkAccessNetworkCondition() *schema.Resource {
 		UpdateContext: resourceNetworkAccessNetworkConditionUpdate,
 		DeleteContext: resourceNetworkAccessNetworkConditionDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceNetworkAccessNetworkConditionRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -397,10 +397,9 @@ func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.Re
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessNetworkConditions", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -408,8 +407,7 @@ func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.Re
 		items1 := getAllItemsNetworkAccessNetworkConditionsGetNetworkAccessNetworkConditions(m, response1)
 		item1, err := searchNetworkAccessNetworkConditionsGetNetworkAccessNetworkConditions(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessNetworkConditions", err, nil)
 		}
 		vItem1 := flattenNetworkAccessNetworkConditionsGetNetworkAccessNetworkConditionByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -433,10 +431,9 @@ func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.Re
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessNetworkConditionByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -506,7 +503,7 @@ func resourceNetworkAccessNetworkConditionUpdate(ctx context.Context, d *schema.
 		response1, restyResp1, err := client.NetworkAccessNetworkConditions.UpdateNetworkAccessNetworkConditionByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessNetworkConditionByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessNetworkConditionByID, unexpected response", ""))
@@ -573,7 +570,7 @@ func resourceNetworkAccessNetworkConditionDelete(ctx context.Context, d *schema.
 	response1, restyResp1, err := client.NetworkAccessNetworkConditions.DeleteNetworkAccessNetworkConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessNetworkConditionByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessNetworkConditionByID, unexpected response", ""))
@@ -0,0 +1,484 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+// networkAccessPolicyBundleRules is a list of rules of the bundle, applied in
+// dependency order.
+type networkAccessPolicyBundleRules struct {
+	key  string
+	what string
+	list ruleList
+}
+
+func networkAccessPolicyBundleRuleLists() []networkAccessPolicyBundleRules {
+	return []networkAccessPolicyBundleRules{
+		{key: "authentication_rules", what: "authentication rules", list: networkAccessAuthenticationRuleList()},
+		{key: "local_exception_rules", what: "local exception rules", list: networkAccessLocalExceptionRuleList()},
+		{key: "authorization_rules", what: "authorization rules", list: networkAccessAuthorizationRuleList()},
+	}
+}
+
+func networkAccessAuthenticationRuleList() ruleList {
+	parameters := resourceNetworkAccessAuthenticationRules().Schema["parameters"].Elem.(*schema.Resource).Schema
+	return ruleList{
+		operation:  "NetworkAccessAuthenticationRule",
+		path:       "/api/v1/policy/network-access/policy-set/%s/authentication",
+		conditions: ruleConditionJSON,
+		results: []ruleListResult{
+			{key: "identity_source_name", apiKey: "identitySourceName", schema: parameters["identity_source_name"]},
+			{key: "if_auth_fail", apiKey: "ifAuthFail", schema: parameters["if_auth_fail"]},
+			{key: "if_process_fail", apiKey: "ifProcessFail", schema: parameters["if_process_fail"]},
+			{key: "if_user_not_found", apiKey: "ifUserNotFound", schema: parameters["if_user_not_found"]},
+		},
+		list: func(client *isegosdk.Client, policyID string) (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessAuthenticationRules.GetNetworkAccessAuthenticationRules(policyID)
+			return restyResp, err
+		},
+		delete: func(client *isegosdk.Client, policyID string, id string) (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessAuthenticationRules.DeleteNetworkAccessAuthenticationRuleByID(policyID, id)
+			return restyResp, err
+		},
+	}
+}
+
+func networkAccessLocalExceptionRuleList() ruleList {
+	parameters := resourceNetworkAccessLocalExceptionRules().Schema["parameters"].Elem.(*schema.Resource).Schema
+	return ruleList{
+		operation:  "NetworkAccessLocalExceptionRule",
+		path:       "/api/v1/policy/network-access/policy-set/%s/exception",
+		conditions: ruleConditionJSON,
+		results: []ruleListResult{
+			{key: "profile", apiKey: "profile", schema: parameters["profile"]},
+			{key: "security_group", apiKey: "securityGroup", schema: parameters["security_group"]},
+		},
+		list: func(client *isegosdk.Client, policyID string) (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessAuthorizationExceptionRules.GetNetworkAccessLocalExceptionRules(policyID)
+			return restyResp, err
+		},
+		delete: func(client *isegosdk.Client, policyID string, id string) (*resty.Response, error) {
+			_, restyResp, err := client.NetworkAccessAuthorizationExceptionRules.DeleteNetworkAccessLocalExceptionRuleByID(policyID, id)
+			return restyResp, err
+		},
+	}
+}
+
+func resourceNetworkAccessPolicyBundle() *schema.Resource {
+	itemSchema := map[string]*schema.Schema{
+		"policy_id": &schema.Schema{
+			Description: `Policy set id`,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+	parametersSchema := map[string]*schema.Schema{
+		"condition_expression": resourceConditionExpressionSchema(),
+		"condition_json":       resourceConditionJSONSchema(),
+		"condition_name":       resourceConditionNameSchema(),
+		"description": &schema.Schema{
+			Description: `The description for the policy set`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"is_proxy": &schema.Schema{
+			Description:      `Flag which indicates if the policy set service is of type 'Proxy Sequence' or 'Allowed Protocols'`,
+			Type:             schema.TypeString,
+			ValidateFunc:     validateStringHasValueFunc([]string{"", "true", "false"}),
+			Optional:         true,
+			DiffSuppressFunc: diffSupressBool(),
+			Computed:         true,
+		},
+		"name": &schema.Schema{
+			Description: `Given name for the policy set, [Valid characters are alphanumerics, underscore, hyphen, space, period, parentheses]`,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"rank": &schema.Schema{
+			Description: `The rank(priority) in relation to other policy set. Lower rank is higher priority.`,
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"service_name": &schema.Schema{
+			Description: `Policy set service identifier - Allowed Protocols,Server Sequence..`,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"state": &schema.Schema{
+			Description:  `The state that the policy set is in. A disabled policy set cannot be matched.`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateStringHasValueFunc([]string{"enabled", "disabled", "monitor"}),
+		},
+	}
+	for _, rules := range networkAccessPolicyBundleRuleLists() {
+		itemSchema[rules.key] = &schema.Schema{
+			Description: fmt.Sprintf("Managed %s, in rank order", rules.what),
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"rank": &schema.Schema{
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+		parametersSchema[rules.key] = &schema.Schema{
+			Description: fmt.Sprintf("The %s of the policy set in priority order. The rank of each rule is its position in the list.", rules.what),
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: rules.list.ruleSchema(),
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Description: `It manages create, read, update and delete operations on a Network Access policy set together with its rules.
+- Creates the policy set, then its authentication rules, local exception rules and authorization rules, each list in rank order.
+- When a step of the creation fails, the policy set is deleted with the rules created so far and the error lists the steps that were completed. When the rollback fails too, the policy set is kept in the state as tainted so the next apply replaces it.
+- Updates are applied in the same order but are not rolled back. The default rules of the policy set are never managed.
+`,
+
+		CreateContext: resourceNetworkAccessPolicyBundleCreate,
+		ReadContext:   resourceNetworkAccessPolicyBundleRead,
+		UpdateContext: resourceNetworkAccessPolicyBundleUpdate,
+		DeleteContext: resourceNetworkAccessPolicyBundleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetworkAccessPolicyBundleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: `Unix timestamp records the last time that the resource was updated.`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"item": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: itemSchema,
+				},
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: parametersSchema,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkAccessPolicyBundleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning NetworkAccessPolicyBundle create")
+	clientConfig := m.(ClientConfig)
+	var diags diag.Diagnostics
+
+	body, err := expandNetworkAccessPolicyBundlePolicySet(d, m)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the policy set of NetworkAccessPolicyBundle", err))
+		return diags
+	}
+	result := map[string]interface{}{}
+	restyResp1, err := executeConditionJSONRequest(clientConfig, "CreateNetworkAccessPolicySet", resty.MethodPost, "/api/v1/policy/network-access/policy-set", body, &result)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing CreateNetworkAccessPolicySet", err, restyResp1))
+		return diags
+	}
+	policyID := interfaceToString(getJSONPath(result, []string{"response", "id"}))
+	if policyID == "" || policyID == "<nil>" {
+		diags = append(diags, diagError(
+			"Failure when executing CreateNetworkAccessPolicySet", fmt.Errorf("no policy set id in the response")))
+		return diags
+	}
+	policySetMutexKV.Lock(policyID)
+	defer policySetMutexKV.Unlock(policyID)
+
+	completed := []string{fmt.Sprintf("created policy set %s (%s)", interfaceToString(body["name"]), policyID)}
+	for _, rules := range networkAccessPolicyBundleRuleLists() {
+		key := "parameters.0." + rules.key
+		ruleDiags := rules.list.apply(ctx, d, m, policyID, key, nil)
+		if ruleDiags.HasError() {
+			return append(diags, rollbackNetworkAccessPolicyBundle(d, m, policyID, completed, rules.what, ruleDiags)...)
+		}
+		diags = append(diags, ruleDiags...)
+		if count := len(d.Get(key).([]interface{})); count > 0 {
+			completed = append(completed, fmt.Sprintf("created %d %s", count, rules.what))
+		}
+	}
+
+	resourceMap := make(map[string]string)
+	resourceMap["policy_id"] = policyID
+	d.SetId(joinResourceID(resourceMap))
+	return append(diags, resourceNetworkAccessPolicyBundleRead(ctx, d, m)...)
+}
+
+// rollbackNetworkAccessPolicyBundle deletes the policy set created by a
+// failed create, with all its rules, and reports the failed step and the
+// steps completed before it. When the policy set cannot be deleted, it is
+// kept in the state so the next apply replaces it.
+func rollbackNetworkAccessPolicyBundle(d *schema.ResourceData, m interface{}, policyID string, completed []string, failed string, failure diag.Diagnostics) diag.Diagnostics {
+	client := m.(ClientConfig).Client
+	var diags diag.Diagnostics
+
+	detail := fmt.Sprintf("Completed steps: %s. Failed step: create %s.", strings.Join(completed, ", "), failed)
+	log.Printf("[DEBUG] Rolling back NetworkAccessPolicyBundle %s. %s", policyID, detail)
+	_, restyResp, err := client.NetworkAccessPolicySet.DeleteNetworkAccessPolicySetByID(policyID)
+	if err != nil && !isNotFoundResponse(restyResp) {
+		resourceMap := make(map[string]string)
+		resourceMap["policy_id"] = policyID
+		d.SetId(joinResourceID(resourceMap))
+		diags = append(diags, failure...)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("NetworkAccessPolicyBundle was partially created, policy set %s was not rolled back", policyID),
+			Detail:   fmt.Sprintf("%s The policy set and the rules created so far were left in place and are tracked as tainted: %v", detail, err),
+		})
+		return diags
+	}
+	diags = append(diags, failure...)
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "NetworkAccessPolicyBundle was rolled back",
+		Detail:   fmt.Sprintf("%s Policy set %s was deleted with the rules created so far.", detail, policyID),
+	})
+	return diags
+}
+
+func resourceNetworkAccessPolicyBundleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+	var diags diag.Diagnostics
+
+	resourceMap := separateResourceID(d.Id())
+	policyID := resourceMap["policy_id"]
+	log.Printf("[DEBUG] Beginning NetworkAccessPolicyBundle read for id=[%s]", d.Id())
+
+	_, restyResp1, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySetByID(policyID)
+	if err != nil || restyResp1 == nil {
+		return diagReadError(d, "Failure when executing GetNetworkAccessPolicySetByID", err, restyResp1)
+	}
+	response := struct {
+		Response map[string]interface{} `json:"response"`
+	}{}
+	if err := json.Unmarshal(restyResp1.Body(), &response); err != nil {
+		diags = append(diags, diagErrorWithResponse(
+			"Failure when decoding GetNetworkAccessPolicySetByID response", err, restyResp1.String()))
+		return diags
+	}
+	policySet := response.Response
+
+	var prior map[string]interface{}
+	if parameters, ok := d.Get("parameters").([]interface{}); ok && len(parameters) > 0 {
+		prior, _ = parameters[0].(map[string]interface{})
+	}
+	vParameters := map[string]interface{}{
+		"description":  conditionString(policySet, "description"),
+		"is_proxy":     fmt.Sprintf("%t", policySet["isProxy"] == true),
+		"name":         conditionString(policySet, "name"),
+		"service_name": conditionString(policySet, "serviceName"),
+		"state":        conditionString(policySet, "state"),
+	}
+	if rank, ok := policySet["rank"].(float64); ok {
+		vParameters["rank"] = int(rank)
+	}
+	flattenPriorCondition(vParameters, normalizeConditionJSON(policySet["condition"]), prior)
+
+	vItem := map[string]interface{}{
+		"policy_id": policyID,
+	}
+	for _, rules := range networkAccessPolicyBundleRuleLists() {
+		entries, restyResp, err := rules.list.entries(client, policyID)
+		if err != nil {
+			diags = append(diags, diagErrorWithOptionalResponse(
+				fmt.Sprintf("Failure when executing Get%ss", rules.list.operation), err, restyResp))
+			return diags
+		}
+		priorRules := map[string]map[string]interface{}{}
+		if v, ok := prior[rules.key].([]interface{}); ok {
+			for _, item := range v {
+				if rule, ok := item.(map[string]interface{}); ok {
+					priorRules[interfaceToString(rule["name"])] = rule
+				}
+			}
+		}
+		vRules := []map[string]interface{}{}
+		vItemRules := []map[string]interface{}{}
+		for _, entry := range entries {
+			vRules = append(vRules, rules.list.flattenEntry(entry, priorRules[entry.name()]))
+			vItemRules = append(vItemRules, map[string]interface{}{
+				"id":   entry.id(),
+				"name": entry.name(),
+				"rank": entry.rank(),
+			})
+		}
+		vParameters[rules.key] = vRules
+		vItem[rules.key] = vItemRules
+	}
+
+	if err := d.Set("item", []map[string]interface{}{vItem}); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting GetNetworkAccessPolicySetByID response",
+			err))
+		return diags
+	}
+	if err := d.Set("parameters", []map[string]interface{}{vParameters}); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting GetNetworkAccessPolicySetByID response to parameters",
+			err))
+		return diags
+	}
+	return diags
+}
+
+func resourceNetworkAccessPolicyBundleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning NetworkAccessPolicyBundle update for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	var diags diag.Diagnostics
+
+	resourceMap := separateResourceID(d.Id())
+	policyID := resourceMap["policy_id"]
+	policySetMutexKV.Lock(policyID)
+	defer policySetMutexKV.Unlock(policyID)
+
+	if d.HasChanges("parameters.0.condition_expression", "parameters.0.condition_json", "parameters.0.condition_name",
+		"parameters.0.description", "parameters.0.is_proxy", "parameters.0.name", "parameters.0.rank",
+		"parameters.0.service_name", "parameters.0.state") {
+		body, err := expandNetworkAccessPolicyBundlePolicySet(d, m)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the policy set of NetworkAccessPolicyBundle", err))
+			return diags
+		}
+		body["id"] = policyID
+		restyResp1, err := executeConditionJSONRequest(clientConfig, "UpdateNetworkAccessPolicySetByID", resty.MethodPut, "/api/v1/policy/network-access/policy-set/"+policyID, body, &map[string]interface{}{})
+		if err != nil {
+			diags = append(diags, diagErrorWithOptionalResponse(
+				"Failure when executing UpdateNetworkAccessPolicySetByID", err, restyResp1))
+			return diags
+		}
+	}
+	for _, rules := range networkAccessPolicyBundleRuleLists() {
+		key := "parameters.0." + rules.key
+		if !d.HasChange(key) {
+			continue
+		}
+		oldRules, _ := d.GetChange(key)
+		removed := []string{}
+		if v, ok := oldRules.([]interface{}); ok {
+			for _, item := range v {
+				if rule, ok := item.(map[string]interface{}); ok {
+					removed = append(removed, interfaceToString(rule["name"]))
+				}
+			}
+		}
+		ruleDiags := rules.list.apply(ctx, d, m, policyID, key, removed)
+		diags = append(diags, ruleDiags...)
+		if ruleDiags.HasError() {
+			return diags
+		}
+	}
+	_ = d.Set("last_updated", getUnixTimeString())
+	return append(diags, resourceNetworkAccessPolicyBundleRead(ctx, d, m)...)
+}
+
+func resourceNetworkAccessPolicyBundleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning NetworkAccessPolicyBundle delete for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+	var diags diag.Diagnostics
+
+	resourceMap := separateResourceID(d.Id())
+	policyID := resourceMap["policy_id"]
+	policySetMutexKV.Lock(policyID)
+	defer policySetMutexKV.Unlock(policyID)
+	_, restyResp1, err := client.NetworkAccessPolicySet.DeleteNetworkAccessPolicySetByID(policyID)
+	if err != nil && !isNotFoundResponse(restyResp1) {
+		diags = append(diags, diagErrorWithOptionalResponse(
+			"Failure when executing DeleteNetworkAccessPolicySetByID", err, restyResp1))
+		return diags
+	}
+	d.SetId("")
+	return diags
+}
+
+// resourceNetworkAccessPolicyBundleImport accepts the policy set id as import
+// ID. All the rules of the policy set except the default rules are then
+// managed by the bundle.
+func resourceNetworkAccessPolicyBundleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := strings.TrimSpace(d.Id())
+	if importID == "" {
+		return nil, fmt.Errorf("invalid import ID, expected the policy set id")
+	}
+	resourceMap := separateResourceID(importID)
+	if !strings.Contains(importID, ":=") {
+		resourceMap = map[string]string{"policy_id": importID}
+	}
+	d.SetId(joinResourceID(resourceMap))
+	if err := diagsToError(resourceNetworkAccessPolicyBundleRead(ctx, d, m)); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("unable to import %q, policy set not found", importID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandNetworkAccessPolicyBundlePolicySet returns the request body of the
+// policy set of the bundle, without its rules.
+func expandNetworkAccessPolicyBundlePolicySet(d *schema.ResourceData, m interface{}) (map[string]interface{}, error) {
+	body := map[string]interface{}{
+		"name":        interfaceToString(d.Get("parameters.0.name")),
+		"description": interfaceToString(d.Get("parameters.0.description")),
+		"serviceName": interfaceToString(d.Get("parameters.0.service_name")),
+	}
+	if v, ok := d.GetOk("parameters.0.state"); ok {
+		body["state"] = interfaceToString(v)
+	}
+	if v, ok := d.GetOk("parameters.0.is_proxy"); ok {
+		body["isProxy"] = interfaceToBoolPtr(v)
+	}
+	if v, ok := d.GetOkExists("parameters.0.rank"); ok {
+		body["rank"] = v
+	}
+	condition, err := policySetConditionJSON.condition(d)
+	if err != nil {
+		return nil, err
+	}
+	if condition != nil {
+		if err := policySetConditionJSON.resolveReferences(m, condition, map[string]string{}); err != nil {
+			return nil, err
+		}
+		body["condition"] = condition
+	}
+	return body, nil
+}
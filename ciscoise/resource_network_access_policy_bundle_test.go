@@ -0,0 +1,127 @@
+package ciscoise
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNetworkAccessPolicyBundleCreateRollback(t *testing.T) {
+	cases := map[string]struct {
+		DeleteStatus int
+		ExpectedID   string
+		Expected     string
+	}{
+		"rolled back": {
+			DeleteStatus: http.StatusOK,
+			ExpectedID:   "",
+			Expected:     "NetworkAccessPolicyBundle was rolled back",
+		},
+		"rollback failure": {
+			DeleteStatus: http.StatusInternalServerError,
+			ExpectedID:   "policy_id:=p1",
+			Expected:     "NetworkAccessPolicyBundle was partially created, policy set p1 was not rolled back",
+		},
+	}
+	for tn, tc := range cases {
+		calls := []string{}
+		m := newTestClientConfig(t, func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, r.Method+" "+r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			switch {
+			case r.Method == http.MethodGet:
+				_, _ = w.Write([]byte(`{"response":[]}`))
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/policy-set"):
+				_, _ = w.Write([]byte(`{"response":{"id":"p1","name":"Wired"}}`))
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/authentication"):
+				_, _ = w.Write([]byte(`{"response":{"rule":{"id":"a1","name":"Dot1X"}}}`))
+			case r.Method == http.MethodDelete:
+				w.WriteHeader(tc.DeleteStatus)
+				_, _ = w.Write([]byte(`{}`))
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"message":"Rule name already exists"}`))
+			}
+		})
+		d := schema.TestResourceDataRaw(t, resourceNetworkAccessPolicyBundle().Schema, map[string]interface{}{
+			"parameters": []interface{}{
+				map[string]interface{}{
+					"name":         "Wired",
+					"service_name": "Default Network Access",
+					"authentication_rules": []interface{}{
+						map[string]interface{}{"name": "Dot1X", "identity_source_name": "All_User_ID_Stores"},
+					},
+					"authorization_rules": []interface{}{
+						map[string]interface{}{"name": "Employees", "profile": []interface{}{"PermitAccess"}},
+					},
+				},
+			},
+		})
+
+		diags := resourceNetworkAccessPolicyBundleCreate(context.Background(), d, m)
+		if !diags.HasError() {
+			t.Fatalf("bad: %s, expected an error", tn)
+		}
+		last := diags[len(diags)-1]
+		if last.Summary != tc.Expected {
+			t.Errorf("bad: %s, expected summary %q, got %q", tn, tc.Expected, last.Summary)
+		}
+		if expected := "Completed steps: created policy set Wired (p1), created 1 authentication rules. Failed step: create authorization rules."; !strings.HasPrefix(last.Detail, expected) {
+			t.Errorf("bad: %s, expected detail %q, got %q", tn, expected, last.Detail)
+		}
+		if d.Id() != tc.ExpectedID {
+			t.Errorf("bad: %s, expected id %q, got %q", tn, tc.ExpectedID, d.Id())
+		}
+		expected := []string{
+			"POST /api/v1/policy/network-access/policy-set",
+			"GET /api/v1/policy/network-access/policy-set/p1/authentication",
+			"POST /api/v1/policy/network-access/policy-set/p1/authentication",
+			"GET /api/v1/policy/network-access/policy-set/p1/exception",
+			"GET /api/v1/policy/network-access/policy-set/p1/authorization",
+			"POST /api/v1/policy/network-access/policy-set/p1/authorization",
+			"DELETE /api/v1/policy/network-access/policy-set/p1",
+		}
+		if !reflect.DeepEqual(calls, expected) {
+			t.Errorf("bad: %s, expected calls %v, got %v", tn, expected, calls)
+		}
+	}
+}
+
+func TestExpandNetworkAccessPolicyBundlePolicySetRank(t *testing.T) {
+	cases := map[string]struct {
+		Parameters map[string]interface{}
+		Rank       interface{}
+		Sent       bool
+	}{
+		"top rank": {
+			Parameters: map[string]interface{}{"name": "Wired", "service_name": "Default Network Access", "rank": 0},
+			Rank:       0,
+			Sent:       true,
+		},
+		"rank": {
+			Parameters: map[string]interface{}{"name": "Wired", "service_name": "Default Network Access", "rank": 2},
+			Rank:       2,
+			Sent:       true,
+		},
+		"no rank": {
+			Parameters: map[string]interface{}{"name": "Wired", "service_name": "Default Network Access"},
+		},
+	}
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceNetworkAccessPolicyBundle().Schema, map[string]interface{}{
+			"parameters": []interface{}{tc.Parameters},
+		})
+		body, err := expandNetworkAccessPolicyBundlePolicySet(d, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, unexpected error %v", tn, err)
+		}
+		rank, sent := body["rank"]
+		if sent != tc.Sent || (sent && rank != tc.Rank) {
+			t.Errorf("bad: %s, expected rank %v sent %t, got %v sent %t", tn, tc.Rank, tc.Sent, rank, sent)
+		}
+	}
+}
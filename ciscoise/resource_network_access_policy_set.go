@@ -7,6 +7,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -31,7 +32,7 @@ func resourceNetworkAccessPolicySet() *schema.Resource {
 		UpdateContext: resourceNetworkAccessPolicySetUpdate,
 		DeleteContext: resourceNetworkAccessPolicySetDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceNetworkAccessPolicySetRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -971,6 +972,9 @@ func resourceNetworkAccessPolicySet() *schema.Resource {
 								},
 							},
 						},
+						"condition_expression": resourceConditionExpressionSchema(),
+						"condition_name":       resourceConditionNameSchema(),
+						"condition_json":       resourceConditionJSONSchema(),
 						"default": &schema.Schema{
 							Description:      `Flag which indicates if this policy set is the default one`,
 							Type:             schema.TypeString,
@@ -1107,7 +1111,20 @@ func resourceNetworkAccessPolicySetCreate(ctx context.Context, d *schema.Resourc
 			}
 		}
 	}
-	resp1, restyResp1, err := client.NetworkAccessPolicySet.CreateNetworkAccessPolicySet(request1)
+	var resp1 *isegosdk.ResponseNetworkAccessPolicySetCreateNetworkAccessPolicySet
+	var restyResp1 *resty.Response
+	body, ok, err := policySetConditionJSON.request(d, m, request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding the condition of CreateNetworkAccessPolicySet", err))
+		return diags
+	}
+	if ok {
+		resp1 = &isegosdk.ResponseNetworkAccessPolicySetCreateNetworkAccessPolicySet{}
+		restyResp1, err = executeConditionJSONRequest(clientConfig, "CreateNetworkAccessPolicySet", resty.MethodPost, "/api/v1/policy/network-access/policy-set", body, resp1)
+	} else {
+		resp1, restyResp1, err = client.NetworkAccessPolicySet.CreateNetworkAccessPolicySet(request1)
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -1157,10 +1174,9 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessPolicySets", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1168,8 +1184,7 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 		items1 := getAllItemsNetworkAccessPolicySetGetNetworkAccessPolicySets(m, response1)
 		item1, err := searchNetworkAccessPolicySetGetNetworkAccessPolicySets(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessPolicySets", err, nil)
 		}
 		// Review flatten function used
 		vItem1 := flattenNetworkAccessPolicySetGetNetworkAccessPolicySetByIDItem(item1)
@@ -1179,6 +1194,7 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 				err))
 			return diags
 		}
+		policySetConditionJSON.flatten(d, vItem1, restyResp1, item1.ID)
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessPolicySets search response",
@@ -1194,10 +1210,9 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessPolicySetByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1209,6 +1224,7 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 				err))
 			return diags
 		}
+		policySetConditionJSON.flatten(d, vItem2, restyResp2, vvID)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessPolicySetByID response",
@@ -1264,10 +1280,23 @@ func resourceNetworkAccessPolicySetUpdate(ctx context.Context, d *schema.Resourc
 		if request1 != nil {
 			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
 		}
-		response1, restyResp1, err := client.NetworkAccessPolicySet.UpdateNetworkAccessPolicySetByID(vvID, request1)
+		var response1 *isegosdk.ResponseNetworkAccessPolicySetUpdateNetworkAccessPolicySetByID
+		var restyResp1 *resty.Response
+		body, ok, err := policySetConditionJSON.request(d, m, request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding the condition of UpdateNetworkAccessPolicySetByID", err))
+			return diags
+		}
+		if ok {
+			response1 = &isegosdk.ResponseNetworkAccessPolicySetUpdateNetworkAccessPolicySetByID{}
+			restyResp1, err = executeConditionJSONRequest(clientConfig, "UpdateNetworkAccessPolicySetByID", resty.MethodPut, "/api/v1/policy/network-access/policy-set/"+vvID, body, response1)
+		} else {
+			response1, restyResp1, err = client.NetworkAccessPolicySet.UpdateNetworkAccessPolicySetByID(vvID, request1)
+		}
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessPolicySetByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessPolicySetByID, unexpected response", ""))
@@ -1333,7 +1362,7 @@ func resourceNetworkAccessPolicySetDelete(ctx context.Context, d *schema.Resourc
 	response1, restyResp1, err := client.NetworkAccessPolicySet.DeleteNetworkAccessPolicySetByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessPolicySetByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessPolicySetByID, unexpected response", ""))
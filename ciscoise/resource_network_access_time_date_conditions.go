@@ -29,7 +29,7 @@ func resourceNetworkAccessTimeDateConditions() *schema.Resource {
 		UpdateContext: resourceNetworkAccessTimeDateConditionsUpdate,
 		DeleteContext: resourceNetworkAccessTimeDateConditionsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceNetworkAccessTimeDateConditionsRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -621,10 +621,9 @@ func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessTimeConditions", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -632,8 +631,7 @@ func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.
 		items1 := getAllItemsNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditions(m, response1)
 		item1, err := searchNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditions(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNetworkAccessTimeConditions", err, nil)
 		}
 		vItem1 := flattenNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditionByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -657,10 +655,9 @@ func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkAccessTimeConditionByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -730,7 +727,7 @@ func resourceNetworkAccessTimeDateConditionsUpdate(ctx context.Context, d *schem
 		response1, restyResp1, err := client.NetworkAccessTimeDateConditions.UpdateNetworkAccessTimeConditionByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessTimeConditionByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessTimeConditionByID, unexpected response", ""))
@@ -797,7 +794,7 @@ func resourceNetworkAccessTimeDateConditionsDelete(ctx context.Context, d *schem
 	response1, restyResp1, err := client.NetworkAccessTimeDateConditions.DeleteNetworkAccessTimeConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessTimeConditionByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessTimeConditionByID, unexpected response", ""))
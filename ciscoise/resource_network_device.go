@@ -33,7 +33,7 @@ func resourceNetworkDevice() *schema.Resource {
 		UpdateContext: resourceNetworkDeviceUpdate,
 		DeleteContext: resourceNetworkDeviceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceNetworkDeviceRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,8 +103,9 @@ func resourceNetworkDevice() *schema.Resource {
 										Computed: true,
 									},
 									"key_encryption_key": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"key_input_format": &schema.Schema{
 										Description: `Allowed values:
@@ -114,8 +115,9 @@ func resourceNetworkDevice() *schema.Resource {
 										Computed: true,
 									},
 									"message_authenticator_code_key": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"network_protocol": &schema.Schema{
 										Description: `Allowed values:
@@ -125,12 +127,14 @@ func resourceNetworkDevice() *schema.Resource {
 										Computed: true,
 									},
 									"radius_shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"second_radius_shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 								},
 							},
@@ -208,8 +212,9 @@ func resourceNetworkDevice() *schema.Resource {
 										Computed: true,
 									},
 									"ro_community": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 									"version": &schema.Schema{
 										Type:     schema.TypeString,
@@ -237,8 +242,9 @@ func resourceNetworkDevice() *schema.Resource {
 										Computed: true,
 									},
 									"shared_secret": &schema.Schema{
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Sensitive: true,
+										Computed:  true,
 									},
 								},
 							},
@@ -260,8 +266,9 @@ func resourceNetworkDevice() *schema.Resource {
 													Computed: true,
 												},
 												"sga_device_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 											},
 										},
@@ -273,12 +280,14 @@ func resourceNetworkDevice() *schema.Resource {
 											Schema: map[string]*schema.Schema{
 
 												"enable_mode_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 												"exec_mode_password": &schema.Schema{
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Sensitive: true,
+													Computed:  true,
 												},
 												"exec_mode_username": &schema.Schema{
 													Type:     schema.TypeString,
@@ -433,6 +442,7 @@ func resourceNetworkDevice() *schema.Resource {
 									},
 									"key_encryption_key": &schema.Schema{
 										Type:             schema.TypeString,
+										Sensitive:        true,
 										Optional:         true,
 										DiffSuppressFunc: diffSupressOptional(),
 										Computed:         true,
@@ -448,6 +458,7 @@ func resourceNetworkDevice() *schema.Resource {
 									},
 									"message_authenticator_code_key": &schema.Schema{
 										Type:             schema.TypeString,
+										Sensitive:        true,
 										Optional:         true,
 										DiffSuppressFunc: diffSupressOptional(),
 										Computed:         true,
@@ -463,12 +474,14 @@ func resourceNetworkDevice() *schema.Resource {
 									},
 									"radius_shared_secret": &schema.Schema{
 										Type:             schema.TypeString,
+										Sensitive:        true,
 										Optional:         true,
 										DiffSuppressFunc: diffSupressOptional(),
 										Computed:         true,
 									},
 									"second_radius_shared_secret": &schema.Schema{
 										Type:             schema.TypeString,
+										Sensitive:        true,
 										Optional:         true,
 										DiffSuppressFunc: diffSupressOptional(),
 										Computed:         true,
@@ -576,6 +589,7 @@ func resourceNetworkDevice() *schema.Resource {
 									},
 									"ro_community": &schema.Schema{
 										Type:             schema.TypeString,
+										Sensitive:        true,
 										Optional:         true,
 										DiffSuppressFunc: diffSupressOptional(),
 										Computed:         true,
@@ -615,6 +629,7 @@ func resourceNetworkDevice() *schema.Resource {
 									},
 									"shared_secret": &schema.Schema{
 										Type:             schema.TypeString,
+										Sensitive:        true,
 										Optional:         true,
 										DiffSuppressFunc: diffSupressOptional(),
 										Computed:         true,
@@ -646,6 +661,7 @@ func resourceNetworkDevice() *schema.Resource {
 												},
 												"sga_device_password": &schema.Schema{
 													Type:             schema.TypeString,
+													Sensitive:        true,
 													Optional:         true,
 													DiffSuppressFunc: diffSupressOptional(),
 													Computed:         true,
@@ -663,12 +679,14 @@ func resourceNetworkDevice() *schema.Resource {
 
 												"enable_mode_password": &schema.Schema{
 													Type:             schema.TypeString,
+													Sensitive:        true,
 													Optional:         true,
 													DiffSuppressFunc: diffSupressOptional(),
 													Computed:         true,
 												},
 												"exec_mode_password": &schema.Schema{
 													Type:             schema.TypeString,
+													Sensitive:        true,
 													Optional:         true,
 													DiffSuppressFunc: diffSupressOptional(),
 													Computed:         true,
@@ -859,10 +877,9 @@ func resourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkDeviceByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -891,10 +908,9 @@ func resourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNetworkDeviceByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -964,7 +980,7 @@ func resourceNetworkDeviceUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.NetworkDevice.UpdateNetworkDeviceByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkDeviceByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkDeviceByID, unexpected response", ""))
@@ -1024,7 +1040,7 @@ func resourceNetworkDeviceDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.NetworkDevice.DeleteNetworkDeviceByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkDeviceByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkDeviceByID, unexpected response", ""))
@@ -243,10 +243,9 @@ func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetDeploymentNodes", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -254,8 +253,7 @@ func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m i
 		items1 := getAllItemsNodeDeploymentGetDeploymentNodes(m, response1)
 		item1, err := searchNodeDeploymentGetDeploymentNodes(m, items1, vHostname, vFQDN, "")
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetDeploymentNodes", err, nil)
 		}
 		vItem1 := flattenNodeDeploymentGetNodeDetailsItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -280,10 +278,9 @@ func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNodeDetails", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -354,7 +351,7 @@ func resourceNodeDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.NodeDeployment.UpdateDeploymentNode(vvHostname, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeploymentNode", err, restyResp1.String(),
 					"Failure at UpdateDeploymentNode, unexpected response", ""))
@@ -422,7 +419,7 @@ func resourceNodeDeploymentDelete(ctx context.Context, d *schema.ResourceData, m
 	response1, restyResp1, err := client.NodeDeployment.DeleteDeploymentNode(vvHostname)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeploymentNode", err, restyResp1.String(),
 				"Failure at DeleteDeploymentNode, unexpected response", ""))
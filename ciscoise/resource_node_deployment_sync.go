@@ -80,7 +80,7 @@ func resourceNodeDeploymentSyncCreate(ctx context.Context, d *schema.ResourceDat
 	response1, restyResp1, err := client.NodeDeployment.SyncNode(vvHostname)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing SyncNode", err, restyResp1.String(),
 				"Failure at SyncNode, unexpected response", ""))
@@ -237,10 +237,9 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNodeGroups", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -248,8 +247,7 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 		items1 := getAllItemsNodeGroupGetNodeGroups(m, response1)
 		item1, err := searchNodeGroupGetNodeGroups(m, items1, vvNodeGroupName, "")
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNodeGroups", err, nil)
 		}
 		vItem1 := flattenNodeGroupGetNodeGroupItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -274,10 +272,9 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNodeGroup", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -346,7 +343,7 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		response1, restyResp1, err := client.NodeGroup.UpdateNodeGroup(vvNodeGroupName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNodeGroup", err, restyResp1.String(),
 					"Failure at UpdateNodeGroup, unexpected response", ""))
@@ -409,7 +406,7 @@ func resourceNodeGroupDelete(ctx context.Context, d *schema.ResourceData, m inte
 	response1, restyResp1, err := client.NodeGroup.DeleteNodeGroup(vvNodeGroupName, nil)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNodeGroup", err, restyResp1.String(),
 				"Failure at DeleteNodeGroup, unexpected response", ""))
@@ -111,18 +111,16 @@ func resourceNodeGroupNodeRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNodes", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
 
 		response_nodes, err := searchNodeGroupGetNodes(m, response1.Response, vvHostname)
 		if err != nil || response_nodes == nil || len(*response_nodes) == 0 {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNodes", err, nil)
 		}
 		vItems1 := flattenNodeGroupGetNodesItems(response_nodes)
 		if err := d.Set("item", vItems1); err != nil {
@@ -174,7 +172,7 @@ func resourceNodeGroupNodeCreate(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing AddNode", err,
@@ -244,7 +242,7 @@ func resourceNodeGroupNodeDelete(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing RemoveNode", err,
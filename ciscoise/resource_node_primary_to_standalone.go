@@ -78,7 +78,7 @@ func resourceNodePrimaryToStandaloneCreate(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.NodeDeployment.MakeStandalone()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing MakeStandalone", err,
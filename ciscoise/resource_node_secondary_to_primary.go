@@ -80,7 +80,7 @@ func resourceNodeSecondaryToPrimaryCreate(ctx context.Context, d *schema.Resourc
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing PromoteNode", err,
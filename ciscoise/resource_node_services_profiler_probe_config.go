@@ -526,7 +526,7 @@ func resourceNodeServicesProfilerProbeConfigCreate(ctx context.Context, d *schem
 	response1, restyResp1, err := client.NodeServices.SetProfilerProbeConfig(vvHostname, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing SetProfilerProbeConfig", err, restyResp1.String(),
 				"Failure at SetProfilerProbeConfig, unexpected response", ""))
@@ -562,10 +562,9 @@ func resourceNodeServicesProfilerProbeConfigRead(ctx context.Context, d *schema.
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetProfilerProbeConfig", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -610,7 +609,7 @@ func resourceNodeServicesProfilerProbeConfigUpdate(ctx context.Context, d *schem
 		response1, restyResp1, err := client.NodeServices.SetProfilerProbeConfig(vvHostname, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SetProfilerProbeConfig", err, restyResp1.String(),
 					"Failure at SetProfilerProbeConfig, unexpected response", ""))
@@ -90,7 +90,7 @@ func resourceNodeServicesSxpInterfacesCreate(ctx context.Context, d *schema.Reso
 	response1, restyResp1, err := client.NodeServices.SetSxpInterface(vHostname, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing SetSxpInterface", err, restyResp1.String(),
 				"Failure at SetSxpInterface, unexpected response", ""))
@@ -127,10 +127,9 @@ func resourceNodeServicesSxpInterfacesRead(ctx context.Context, d *schema.Resour
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSxpInterface", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -174,7 +173,7 @@ func resourceNodeServicesSxpInterfacesUpdate(ctx context.Context, d *schema.Reso
 		response1, restyResp1, err := client.NodeServices.SetSxpInterface(vHostname, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SetSxpInterface", err, restyResp1.String(),
 					"Failure at SetSxpInterface, unexpected response", ""))
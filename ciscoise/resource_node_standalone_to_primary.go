@@ -85,7 +85,7 @@ func resourceNodeStandaloneToPrimaryCreate(ctx context.Context, d *schema.Resour
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing MakePrimary", err,
@@ -140,7 +140,7 @@ func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interfac
 	if err != nil || response1 == nil {
 		if response1 != nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing InstallPatch", err,
@@ -153,7 +153,7 @@ func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interfac
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", restyResp1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(restyResp1.String()))
 
 	if response1.Response != nil {
 		taskID := response1.Response.ID
@@ -161,7 +161,7 @@ func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interfac
 			taskResponse, restyResp2, err := client.Tasks.GetTaskStatusByID(taskID)
 			if err != nil || taskResponse == nil {
 				if restyResp2 != nil {
-					log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+					log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 				}
 				diags = append(diags, diagErrorWithAlt(
 					"Failure when executing GetTaskStatusByID", err,
@@ -173,7 +173,7 @@ func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interfac
 				taskResponse, restyResp2, err = client.Tasks.GetTaskStatusByID(taskID)
 				if err != nil || taskResponse == nil {
 					if restyResp2 != nil {
-						log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+						log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 					}
 				}
 			}
@@ -203,16 +203,14 @@ func resourcePatchRead(ctx context.Context, d *schema.ResourceData, m interface{
 	response1, restyResp1, err := client.Patching.ListInstalledPatches()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
-		d.SetId("")
-		return diags
+		return diagReadError(d, "Failure when executing ListInstalledPatches", err, restyResp1)
 	}
 	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
 	item1, err := searchPatch(m, response1, vvPatchNumber)
 	if err != nil || item1 == nil {
-		d.SetId("")
-		return diags
+		return diagReadError(d, "Failure when searching ListInstalledPatches", err, nil)
 	}
 
 	vItem1 := flattenPatchingListInstalledPatchesItemPatchVersion(item1)
@@ -247,7 +245,7 @@ func resourcePatchDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	getResp1, restyResp1, err := client.Patching.ListInstalledPatches()
 	if err != nil || getResp1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		d.SetId("")
 		return diags
@@ -270,7 +268,7 @@ func resourcePatchDelete(ctx context.Context, d *schema.ResourceData, m interfac
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing RollbackPatch", err,
@@ -286,7 +284,7 @@ func resourcePatchDelete(ctx context.Context, d *schema.ResourceData, m interfac
 			taskResponse, restyResp2, err := client.Tasks.GetTaskStatusByID(taskID)
 			if err != nil || taskResponse == nil {
 				if restyResp2 != nil {
-					log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+					log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 				}
 				diags = append(diags, diagErrorWithAlt(
 					"Failure when executing GetTaskStatusByID", err,
@@ -298,7 +296,7 @@ func resourcePatchDelete(ctx context.Context, d *schema.ResourceData, m interfac
 				taskResponse, restyResp2, err = client.Tasks.GetTaskStatusByID(taskID)
 				if err != nil || taskResponse == nil {
 					if restyResp2 != nil {
-						log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+						log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 					}
 				}
 			}
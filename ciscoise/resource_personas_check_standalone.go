@@ -60,6 +60,7 @@ func resourcePersonasCheckStandalone() *schema.Resource {
 						"password": &schema.Schema{
 							Description: `password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							ForceNew:    true,
 						}},
@@ -71,16 +72,17 @@ func resourcePersonasCheckStandalone() *schema.Resource {
 
 func resourcePersonasCheckStandaloneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning PersonasCheckStandalone")
+	clientConfig := m.(ClientConfig)
 	var diags diag.Diagnostics
 	node := expandRequestPersonasCheckStandalone(ctx, "parameters.0", d)
-	isStandAlone, err := node.IsStandAlone()
+	isStandAlone, err := node.IsStandAlone(clientConfig.NodeClients)
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing IsStandAlone function", err,
 			"Failure at IsStandAlone, unexpected response", ""))
 		return diags
 	}
-	serverIsRunning, err := node.AppServerIsRunning()
+	serverIsRunning, err := node.AppServerIsRunning(clientConfig.NodeClients)
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing AppServerIsRunning function", err,
@@ -54,6 +54,7 @@ func resourcePersonasExportCerts() *schema.Resource {
 						"primary_password": &schema.Schema{
 							Description: `Primary password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							ForceNew:    true,
 						},
@@ -66,6 +67,7 @@ func resourcePersonasExportCerts() *schema.Resource {
 						"password": &schema.Schema{
 							Description: `password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							ForceNew:    true,
 						},
@@ -96,11 +98,12 @@ func resourcePersonasExportCerts() *schema.Resource {
 
 func resourcePersonasExportCertsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning PersonasExportCerts")
+	clientConfig := m.(ClientConfig)
 	var diags diag.Diagnostics
 	node := expandRequestPersonasExportCerts(ctx, "parameters.0", d)
 	primaryNode := expandRequestPersonasExportCertsPrimary(ctx, "parameters.0", d)
 
-	err := node.ImportCertificateIntoPrimary(primaryNode)
+	err := node.ImportCertificateIntoPrimary(clientConfig.NodeClients, primaryNode)
 
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
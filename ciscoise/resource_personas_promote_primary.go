@@ -53,6 +53,7 @@ func resourcePersonasPromotePrimary() *schema.Resource {
 						"password": &schema.Schema{
 							Description: `password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							ForceNew:    true,
 						},
@@ -65,10 +66,11 @@ func resourcePersonasPromotePrimary() *schema.Resource {
 
 func resourcePersonasPromotePrimaryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning PersonasPromotePrimary")
+	clientConfig := m.(ClientConfig)
 	var diags diag.Diagnostics
 	node := expandRequestPersonasPromotePrimary(ctx, "parameters.0", d)
 
-	err := node.PromoteToPrimary()
+	err := node.PromoteToPrimary(clientConfig.NodeClients)
 
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
@@ -54,6 +54,7 @@ func resourcePersonasRegisterNode() *schema.Resource {
 						"primary_password": &schema.Schema{
 							Description: `Primary password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							ForceNew:    true,
 						},
@@ -66,6 +67,7 @@ func resourcePersonasRegisterNode() *schema.Resource {
 						"password": &schema.Schema{
 							Description: `password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							ForceNew:    true,
 						},
@@ -102,11 +104,12 @@ func resourcePersonasRegisterNode() *schema.Resource {
 
 func resourcePersonasRegisterNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning PersonasRegisterNode")
+	clientConfig := m.(ClientConfig)
 	var diags diag.Diagnostics
 	node := expandRequestPersonasRegisterNode(ctx, "parameters.0", d)
 	primaryNode := expandRequestPersonasRegisterNodePrimary(ctx, "parameters.0", d)
 
-	primaryAppServerIsRunning, err := primaryNode.AppServerIsRunning()
+	primaryAppServerIsRunning, err := primaryNode.AppServerIsRunning(clientConfig.NodeClients)
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing AppServerIsRunning function", err,
@@ -120,7 +123,7 @@ func resourcePersonasRegisterNodeCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 
-	err = node.RegisterToPrimary(primaryNode)
+	err = node.RegisterToPrimary(clientConfig.NodeClients, primaryNode)
 
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
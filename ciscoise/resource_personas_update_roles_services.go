@@ -59,6 +59,7 @@ func resourcePersonasUpdateRolesServices() *schema.Resource {
 						"password": &schema.Schema{
 							Description: `password`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							ForceNew:    true,
 						},
@@ -89,9 +90,10 @@ func resourcePersonasUpdateRolesServices() *schema.Resource {
 
 func resourcePersonasUpdateRolesServicesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning PersonasUpdateRolesServices")
+	clientConfig := m.(ClientConfig)
 	var diags diag.Diagnostics
 	node := expandRequestPersonasUpdateRolesServices(ctx, "parameters.0", d)
-	err := node.UpdateRolesServices()
+	err := node.UpdateRolesServices(clientConfig.NodeClients)
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing UpdateRolesServices function", err,
@@ -144,7 +144,7 @@ func resourcePortalGlobalSettingCreate(ctx context.Context, d *schema.ResourceDa
 	response1, restyResp1, err := client.PortalGlobalSetting.UpdatePortalGlobalSettingByID(vvID, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdatePortalGlobalSettingByID", err, restyResp1.String(),
 				"Failure at UpdatePortalGlobalSettingByID, unexpected response", ""))
@@ -187,10 +187,9 @@ func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetPortalGlobalSettings", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -198,8 +197,7 @@ func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData
 		items1 := getAllItemsPortalGlobalSettingGetPortalGlobalSettings(m, response1, &queryParams1)
 		item1, err := searchPortalGlobalSettingGetPortalGlobalSettings(m, items1, "", vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetPortalGlobalSettings", err, nil)
 		}
 		vItem1 := flattenPortalGlobalSettingGetPortalGlobalSettingByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -224,10 +222,9 @@ func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetPortalGlobalSettingByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -295,7 +292,7 @@ func resourcePortalGlobalSettingUpdate(ctx context.Context, d *schema.ResourceDa
 		response1, restyResp1, err := client.PortalGlobalSetting.UpdatePortalGlobalSettingByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdatePortalGlobalSettingByID", err, restyResp1.String(),
 					"Failure at UpdatePortalGlobalSettingByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourcePortalTheme() *schema.Resource {
 		UpdateContext: resourcePortalThemeUpdate,
 		DeleteContext: resourcePortalThemeDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourcePortalThemeRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -242,10 +242,9 @@ func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetPortalThemes", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -253,8 +252,7 @@ func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m inte
 		items1 := getAllItemsPortalThemeGetPortalThemes(m, response1, &queryParams1)
 		item1, err := searchPortalThemeGetPortalThemes(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetPortalThemes", err, nil)
 		}
 		vItem1 := flattenPortalThemeGetPortalThemeByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -279,10 +277,9 @@ func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetPortalThemeByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -355,7 +352,7 @@ func resourcePortalThemeUpdate(ctx context.Context, d *schema.ResourceData, m in
 		response1, restyResp1, err := client.PortalTheme.UpdatePortalThemeByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdatePortalThemeByID", err, restyResp1.String(),
 					"Failure at UpdatePortalThemeByID, unexpected response", ""))
@@ -423,7 +420,7 @@ func resourcePortalThemeDelete(ctx context.Context, d *schema.ResourceData, m in
 	restyResp1, err := client.PortalTheme.DeletePortalThemeByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeletePortalThemeByID", err, restyResp1.String(),
 				"Failure at DeletePortalThemeByID, unexpected response", ""))
@@ -171,7 +171,7 @@ func resourceProxyConnectionSettingsCreate(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.Proxy.UpdateProxyConnection(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateProxyConnection", err, restyResp1.String(),
 				"Failure at UpdateProxyConnection, unexpected response", ""))
@@ -203,10 +203,9 @@ func resourceProxyConnectionSettingsRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetProxyConnection", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -246,7 +245,7 @@ func resourceProxyConnectionSettingsUpdate(ctx context.Context, d *schema.Resour
 		response1, restyResp1, err := client.Proxy.UpdateProxyConnection(request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateProxyConnection", err, restyResp1.String(),
 					"Failure at UpdateProxyConnection, unexpected response", ""))
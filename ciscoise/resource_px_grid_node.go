@@ -186,10 +186,9 @@ func resourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m inter
 		response1, restyResp1, err := client.PxGridNode.GetPxGridNodeByName(vvName)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetPxGridNodeByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -233,7 +232,7 @@ func resourcePxGridNodeDelete(ctx context.Context, d *schema.ResourceData, m int
 	restyResp1, err := client.PxGridNode.DeletePxGridNodeByName(vName)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeletePxGridNodeByName", err, restyResp1.String(),
 				"Failure at DeletePxGridNodeByName, unexpected response", ""))
@@ -72,7 +72,7 @@ func resourcePxGridSettingsAutoApproveCreate(ctx context.Context, d *schema.Reso
 	response1, err := client.PxGridSettings.AutoapprovePxGridSettings(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing AutoapprovePxGridSettings", err, response1.String(),
 				"Failure at AutoapprovePxGridSettings, unexpected response", ""))
@@ -83,7 +83,7 @@ func resourcePxGridSettingsAutoApproveCreate(ctx context.Context, d *schema.Reso
 			"Failure at AutoapprovePxGridSettings, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting AutoapprovePxGridSettings response",
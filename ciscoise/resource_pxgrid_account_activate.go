@@ -66,7 +66,7 @@ func resourcePxgridAccountActivateCreate(ctx context.Context, d *schema.Resource
 	response1, err := client.Consumer.ActivateAccount(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ActivateAccount", err, response1.String(),
 				"Failure at ActivateAccount, unexpected response", ""))
@@ -77,7 +77,7 @@ func resourcePxgridAccountActivateCreate(ctx context.Context, d *schema.Resource
 			"Failure at ActivateAccount, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
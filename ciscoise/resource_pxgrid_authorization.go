@@ -53,7 +53,7 @@ func resourcePxgridAuthorizationCreate(ctx context.Context, d *schema.ResourceDa
 	response1, err := client.Provider.Authorization()
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing Authorization", err, response1.String(),
 				"Failure at Authorization, unexpected response", ""))
@@ -66,7 +66,7 @@ func resourcePxgridServiceLookupCreate(ctx context.Context, d *schema.ResourceDa
 	response1, err := client.Consumer.LookupService(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing LookupService", err, response1.String(),
 				"Failure at LookupService, unexpected response", ""))
@@ -77,7 +77,7 @@ func resourcePxgridServiceLookupCreate(ctx context.Context, d *schema.ResourceDa
 			"Failure at LookupService, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -53,7 +53,7 @@ func resourcePxgridServiceUnregisterCreate(ctx context.Context, d *schema.Resour
 	response1, err := client.Provider.UnregisterService()
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UnregisterService", err, response1.String(),
 				"Failure at UnregisterService, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceRadiusServerSequence() *schema.Resource {
 		UpdateContext: resourceRadiusServerSequenceUpdate,
 		DeleteContext: resourceRadiusServerSequenceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceRadiusServerSequenceRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -514,10 +514,9 @@ func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetRadiusServerSequence", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -525,8 +524,7 @@ func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 		items1 := getAllItemsRadiusServerSequenceGetRadiusServerSequence(m, response1, &queryParams1)
 		item1, err := searchRadiusServerSequenceGetRadiusServerSequence(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetRadiusServerSequence", err, nil)
 		}
 		vItem1 := flattenRadiusServerSequenceGetRadiusServerSequenceByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -551,10 +549,9 @@ func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetRadiusServerSequenceByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -626,7 +623,7 @@ func resourceRadiusServerSequenceUpdate(ctx context.Context, d *schema.ResourceD
 		response1, restyResp1, err := client.RadiusServerSequence.UpdateRadiusServerSequenceByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateRadiusServerSequenceByID", err, restyResp1.String(),
 					"Failure at UpdateRadiusServerSequenceByID, unexpected response", ""))
@@ -694,7 +691,7 @@ func resourceRadiusServerSequenceDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.RadiusServerSequence.DeleteRadiusServerSequenceByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteRadiusServerSequenceByID", err, restyResp1.String(),
 				"Failure at DeleteRadiusServerSequenceByID, unexpected response", ""))
@@ -103,7 +103,7 @@ func resourceRenewCertificateCreate(ctx context.Context, d *schema.ResourceData,
 	response1, restyResp1, err := client.Certificates.RenewCerts(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing RenewCerts", err, restyResp1.String(),
 				"Failure at RenewCerts, unexpected response", ""))
@@ -213,10 +213,9 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetRepositories", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -224,8 +223,7 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 		items1 := getAllItemsRepositoryGetRepositories(m, response1)
 		item1, err := searchRepositoryGetRepositories(m, items1, vvName, "")
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetRepositories", err, nil)
 		}
 		vItem1 := flattenRepositoryGetRepositoryItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -250,10 +248,9 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetRepository", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -322,7 +319,7 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, m int
 		response1, restyResp1, err := client.Repository.UpdateRepository(vvName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateRepository", err, restyResp1.String(),
 					"Failure at UpdateRepository, unexpected response", ""))
@@ -388,7 +385,7 @@ func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, m int
 	response1, restyResp1, err := client.Repository.DeleteRepository(vvName)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteRepository", err, restyResp1.String(),
 				"Failure at DeleteRepository, unexpected response", ""))
@@ -33,7 +33,7 @@ func resourceRestIDStore() *schema.Resource {
 		UpdateContext: resourceRestIDStoreUpdate,
 		DeleteContext: resourceRestIDStoreDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceRestIDStoreRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -330,10 +330,9 @@ func resourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetRestIDStoreByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -362,10 +361,9 @@ func resourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetRestIDStoreByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -435,7 +433,7 @@ func resourceRestIDStoreUpdate(ctx context.Context, d *schema.ResourceData, m in
 		response1, restyResp1, err := client.RestidStore.UpdateRestIDStoreByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateRestIDStoreByID", err, restyResp1.String(),
 					"Failure at UpdateRestIDStoreByID, unexpected response", ""))
@@ -495,7 +493,7 @@ func resourceRestIDStoreDelete(ctx context.Context, d *schema.ResourceData, m in
 	restyResp1, err := client.RestidStore.DeleteRestIDStoreByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteRestIDStoreByID", err, restyResp1.String(),
 				"Failure at DeleteRestIDStoreByID, unexpected response", ""))
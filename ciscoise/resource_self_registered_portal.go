@@ -29,7 +29,7 @@ func resourceSelfRegisteredPortal() *schema.Resource {
 		UpdateContext: resourceSelfRegisteredPortalUpdate,
 		DeleteContext: resourceSelfRegisteredPortalDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSelfRegisteredPortalRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -2905,10 +2905,9 @@ func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSelfRegisteredPortals", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -2916,8 +2915,7 @@ func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceDat
 		items1 := getAllItemsSelfRegisteredPortalGetSelfRegisteredPortals(m, response1, &queryParams1)
 		item1, err := searchSelfRegisteredPortalGetSelfRegisteredPortals(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSelfRegisteredPortals", err, nil)
 		}
 		vItem1 := flattenSelfRegisteredPortalGetSelfRegisteredPortalByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -2942,10 +2940,9 @@ func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSelfRegisteredPortalByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -3017,7 +3014,7 @@ func resourceSelfRegisteredPortalUpdate(ctx context.Context, d *schema.ResourceD
 		response1, restyResp1, err := client.SelfRegisteredPortal.UpdateSelfRegisteredPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSelfRegisteredPortalByID", err, restyResp1.String(),
 					"Failure at UpdateSelfRegisteredPortalByID, unexpected response", ""))
@@ -3085,7 +3082,7 @@ func resourceSelfRegisteredPortalDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.SelfRegisteredPortal.DeleteSelfRegisteredPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSelfRegisteredPortalByID", err, restyResp1.String(),
 				"Failure at DeleteSelfRegisteredPortalByID, unexpected response", ""))
@@ -303,7 +303,7 @@ func resourceSelfsignedCertificateGenerateCreate(ctx context.Context, d *schema.
 	response1, restyResp1, err := client.Certificates.GenerateSelfSignedCertificate(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing GenerateSelfSignedCertificate", err, restyResp1.String(),
 				"Failure at GenerateSelfSignedCertificate, unexpected response", ""))
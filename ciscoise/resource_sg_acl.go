@@ -22,6 +22,8 @@ func resourceSgACL() *schema.Resource {
 - This resource deletes a security group ACL.
 
 - This resource creates a security group ACL.
+
+- aclcontent is parsed at plan time, one ACE per line: permit or deny, a protocol, optional src and dst port conditions (eq, neq, gt, lt, range) for tcp and udp, an optional icmp type and code, established for tcp and log. remark lines are accepted. Case and whitespace differences are ignored, and item.aces holds the parsed ACEs.
 `,
 
 		CreateContext: resourceSgACLCreate,
@@ -29,7 +31,7 @@ func resourceSgACL() *schema.Resource {
 		UpdateContext: resourceSgACLUpdate,
 		DeleteContext: resourceSgACLDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSgACLRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,6 +46,74 @@ func resourceSgACL() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 
+						"aces": &schema.Schema{
+							Description: `ACEs parsed from aclcontent, empty when it does not parse`,
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+
+									"ace": &schema.Schema{
+										Description: `Normalized ACE`,
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"action": &schema.Schema{
+										Description: `permit or deny, empty for remarks`,
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"destination_port_operator": &schema.Schema{
+										Description: `eq, neq, gt, lt or range`,
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"destination_ports": &schema.Schema{
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"established": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"icmp_code": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"icmp_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"log": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"protocol": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"remark": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_port_operator": &schema.Schema{
+										Description: `eq, neq, gt, lt or range`,
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"source_ports": &schema.Schema{
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
 						"aclcontent": &schema.Schema{
 							Type:     schema.TypeString,
 							Computed: true,
@@ -116,7 +186,8 @@ func resourceSgACL() *schema.Resource {
 						"aclcontent": &schema.Schema{
 							Type:             schema.TypeString,
 							Optional:         true,
-							DiffSuppressFunc: diffSupressOptional(),
+							DiffSuppressFunc: diffSuppressSGACLContent(),
+							ValidateFunc:     validateSGACLContent(),
 							Computed:         true,
 						},
 						"description": &schema.Schema{
@@ -293,10 +364,9 @@ func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSecurityGroupsACL", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -304,11 +374,10 @@ func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 		items1 := getAllItemsSecurityGroupsACLsGetSecurityGroupsACL(m, response1, &queryParams1)
 		item1, err := searchSecurityGroupsACLsGetSecurityGroupsACL(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSecurityGroupsACL", err, nil)
 		}
 		vItem1 := flattenSecurityGroupsACLsGetSecurityGroupsACLByIDItem(item1)
-		if err := d.Set("item", vItem1); err != nil {
+		if err := d.Set("item", flattenSgACLItemAces(vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroupsACL search response",
 				err))
@@ -330,16 +399,15 @@ func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSecurityGroupsACLByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSecurityGroupsACLsGetSecurityGroupsACLByIDItem(response2.Sgacl)
-		if err := d.Set("item", vItem2); err != nil {
+		if err := d.Set("item", flattenSgACLItemAces(vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroupsACLByID response",
 				err))
@@ -406,7 +474,7 @@ func resourceSgACLUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 		response1, restyResp1, err := client.SecurityGroupsACLs.UpdateSecurityGroupsACLByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSecurityGroupsACLByID", err, restyResp1.String(),
 					"Failure at UpdateSecurityGroupsACLByID, unexpected response", ""))
@@ -475,7 +543,7 @@ func resourceSgACLDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	restyResp1, err := client.SecurityGroupsACLs.DeleteSecurityGroupsACLByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSecurityGroupsACLByID", err, restyResp1.String(),
 				"Failure at DeleteSecurityGroupsACLByID, unexpected response", ""))
@@ -649,3 +717,19 @@ func searchSecurityGroupsACLsGetSecurityGroupsACL(m interface{}, items []isegosd
 	}
 	return foundItem, err
 }
+
+// flattenSgACLItemAces returns a copy of item with the ACEs parsed from its
+// aclcontent, which are not part of parameters.
+func flattenSgACLItemAces(item []map[string]interface{}) []map[string]interface{} {
+	if len(item) == 0 {
+		return item
+	}
+	respItem := make(map[string]interface{})
+	for k, v := range item[0] {
+		respItem[k] = v
+	}
+	respItem["aces"] = flattenSGACLContent(interfaceToString(item[0]["aclcontent"]))
+	return []map[string]interface{}{
+		respItem,
+	}
+}
@@ -29,7 +29,7 @@ func resourceSgMapping() *schema.Resource {
 		UpdateContext: resourceSgMappingUpdate,
 		DeleteContext: resourceSgMappingDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSgMappingRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -297,10 +297,9 @@ func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetIPToSgtMapping", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -308,8 +307,7 @@ func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interf
 		items1 := getAllItemsIPToSgtMappingGetIPToSgtMapping(m, response1, &queryParams1)
 		item1, err := searchIPToSgtMappingGetIPToSgtMapping(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetIPToSgtMapping", err, nil)
 		}
 		vItem1 := flattenIPToSgtMappingGetIPToSgtMappingByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -334,10 +332,9 @@ func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interf
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetIPToSgtMappingByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -408,7 +405,7 @@ func resourceSgMappingUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		response1, restyResp1, err := client.IPToSgtMapping.UpdateIPToSgtMappingByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateIPToSgtMappingByID", err, restyResp1.String(),
 					"Failure at UpdateIPToSgtMappingByID, unexpected response", ""))
@@ -476,7 +473,7 @@ func resourceSgMappingDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.IPToSgtMapping.DeleteIPToSgtMappingByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteIPToSgtMappingByID", err, restyResp1.String(),
 				"Failure at DeleteIPToSgtMappingByID, unexpected response", ""))
@@ -5,6 +5,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,22 +15,28 @@ func resourceSgMappingDeploy() *schema.Resource {
 		Description: `It performs update operation on IPToSGTMapping.
 - This resource allows the client to deploy an IP to SGT mapping by ID.
 Only one Deploy process can run at any given time
+- Waits for the deploy to finish, up to the create timeout, and stores the network devices the mappings were deployed to or failed on in item. A deploy that failed on any device is reported as an error and the resource is tainted, so the next apply deploys again.
+- ISE only reports the status of the last deploy, so the result is taken once the deploy is seen in progress or its start or end time, or else the status, differs from the one read before the deploy. An unchanged SUCCESS status with no time to tell the deploys apart is taken after 30 seconds. A deploy rejected because another deploy is running is reported as an error.
 `,
 
 		CreateContext: resourceSgMappingDeployCreate,
 		ReadContext:   resourceSgMappingDeployRead,
 		DeleteContext: resourceSgMappingDeployDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(SG_MAPPING_DEPLOY_TIMEOUT),
+		},
+
+		SchemaVersion:  1,
+		StateUpgraders: resourceSgMappingDeployStateUpgraders(resourceSgMappingDeployParametersV0()),
+
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
-			"item": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			"item": sgMappingDeployItemSchema(),
 			"parameters": &schema.Schema{
 				Type:     schema.TypeList,
 				Required: true,
@@ -53,38 +60,27 @@ Only one Deploy process can run at any given time
 
 func resourceSgMappingDeployCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning DeployIPToSgtMappingByID create")
-	log.Printf("[DEBUG] Missing DeployIPToSgtMappingByID create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
+	vvID := interfaceToString(d.Get("parameters.0.id"))
 
-	var diags diag.Diagnostics
-	vID := d.Get("parameters.0.id")
-
-	log.Printf("[DEBUG] Selected method: DeployIPToSgtMappingByID")
-	vvID := vID.(string)
-
-	response1, err := client.IPToSgtMapping.DeployIPToSgtMappingByID(vvID)
-
-	if err != nil || response1 == nil {
-		diags = append(diags, diagErrorWithAlt(
-			"Failure when executing DeployIPToSgtMappingByID", err,
-			"Failure at DeployIPToSgtMappingByID, unexpected response", ""))
+	diags := sgMappingDeployCreate(ctx, d, "DeployIPToSgtMappingByID", func() (*resty.Response, error) {
+		return client.IPToSgtMapping.DeployIPToSgtMappingByID(vvID)
+	}, sgMappingDeployStatus(client))
+	if d.Id() == "" {
 		return diags
 	}
+	return append(diags, resourceSgMappingDeployRead(ctx, d, m)...)
+}
 
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
-
-	if err := d.Set("item", response1.String()); err != nil {
-		diags = append(diags, diagError(
-			"Failure when setting DeployIPToSgtMappingByID response",
-			err))
-		return diags
+func resourceSgMappingDeployParametersV0() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
 	}
-
-	_ = d.Set("last_updated", getUnixTimeString())
-
-	d.SetId(getUnixTimeString())
-	return resourceSgMappingDeployRead(ctx, d, m)
 }
 
 func resourceSgMappingDeployRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -29,7 +29,7 @@ func resourceSgMappingGroup() *schema.Resource {
 		UpdateContext: resourceSgMappingGroupUpdate,
 		DeleteContext: resourceSgMappingGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSgMappingGroupRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -252,10 +252,9 @@ func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetIPToSgtMappingGroup", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -263,8 +262,7 @@ func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m i
 		items1 := getAllItemsIPToSgtMappingGroupGetIPToSgtMappingGroup(m, response1, &queryParams1)
 		item1, err := searchIPToSgtMappingGroupGetIPToSgtMappingGroup(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetIPToSgtMappingGroup", err, nil)
 		}
 		vItem1 := flattenIPToSgtMappingGroupGetIPToSgtMappingGroupByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -289,10 +287,9 @@ func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetIPToSgtMappingGroupByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -359,7 +356,7 @@ func resourceSgMappingGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.IPToSgtMappingGroup.UpdateIPToSgtMappingGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateIPToSgtMappingGroupByID", err, restyResp1.String(),
 					"Failure at UpdateIPToSgtMappingGroupByID, unexpected response", ""))
@@ -422,7 +419,7 @@ func resourceSgMappingGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.IPToSgtMappingGroup.DeleteIPToSgtMappingGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteIPToSgtMappingGroupByID", err, restyResp1.String(),
 				"Failure at DeleteIPToSgtMappingGroupByID, unexpected response", ""))
@@ -5,6 +5,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,22 +15,28 @@ func resourceSgMappingGroupDeploy() *schema.Resource {
 		Description: `It performs update operation on IPToSGTMappingGroup.
 - This resource allows the client to deploy an IP to SGT mapping group by ID.
 Only one Deploy process can run at any given time
+- Waits for the deploy to finish, up to the create timeout, and stores the network devices the mapping groups were deployed to or failed on in item. A deploy that failed on any device is reported as an error and the resource is tainted, so the next apply deploys again.
+- ISE only reports the status of the last deploy, so the result is taken once the deploy is seen in progress or its start or end time, or else the status, differs from the one read before the deploy. An unchanged SUCCESS status with no time to tell the deploys apart is taken after 30 seconds. A deploy rejected because another deploy is running is reported as an error.
 `,
 
 		CreateContext: resourceSgMappingGroupDeployCreate,
 		ReadContext:   resourceSgMappingGroupDeployRead,
 		DeleteContext: resourceSgMappingGroupDeployDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(SG_MAPPING_DEPLOY_TIMEOUT),
+		},
+
+		SchemaVersion:  1,
+		StateUpgraders: resourceSgMappingDeployStateUpgraders(resourceSgMappingGroupDeployParametersV0()),
+
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
-			"item": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			"item": sgMappingDeployItemSchema(),
 			"parameters": &schema.Schema{
 				Type:     schema.TypeList,
 				Required: true,
@@ -53,39 +60,27 @@ Only one Deploy process can run at any given time
 
 func resourceSgMappingGroupDeployCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning DeployIPToSgtMappingGroupByID create")
-	log.Printf("[DEBUG] Missing DeployIPToSgtMappingGroupByID create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
+	vvID := interfaceToString(d.Get("parameters.0.id"))
 
-	var diags diag.Diagnostics
-	resourceItem := *getResourceItem(d.Get("parameters"))
-	vID := resourceItem["id"]
-	vvID := vID.(string)
-	response1, err := client.IPToSgtMappingGroup.DeployIPToSgtMappingGroupByID(vvID)
-	if err != nil || response1 == nil {
-		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
-			diags = append(diags, diagErrorWithAltAndResponse(
-				"Failure when executing DeployIPToSgtMappingGroupByID", err, response1.String(),
-				"Failure at DeployIPToSgtMappingGroupByID, unexpected response", ""))
-			return diags
-		}
-		diags = append(diags, diagErrorWithAlt(
-			"Failure when executing DeployIPToSgtMappingGroupByID", err,
-			"Failure at DeployIPToSgtMappingGroupByID, unexpected response", ""))
+	diags := sgMappingDeployCreate(ctx, d, "DeployIPToSgtMappingGroupByID", func() (*resty.Response, error) {
+		return client.IPToSgtMappingGroup.DeployIPToSgtMappingGroupByID(vvID)
+	}, sgMappingGroupDeployStatus(client))
+	if d.Id() == "" {
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-	if err := d.Set("item", response1.String()); err != nil {
-		diags = append(diags, diagError(
-			"Failure when setting DeployIPToSgtMappingGroupByID response",
-			err))
-		return diags
-	}
-	_ = d.Set("last_updated", getUnixTimeString())
+	return append(diags, resourceSgMappingGroupDeployRead(ctx, d, m)...)
+}
 
-	d.SetId(getUnixTimeString())
-	return resourceSgMappingGroupDeployRead(ctx, d, m)
+func resourceSgMappingGroupDeployParametersV0() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
 }
 
 func resourceSgMappingGroupDeployRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
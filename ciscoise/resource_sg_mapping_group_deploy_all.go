@@ -5,6 +5,7 @@ import (
 
 	"log"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,22 +15,28 @@ func resourceSgMappingGroupDeployAll() *schema.Resource {
 		Description: `It performs update operation on IPToSGTMappingGroup.
 - This resource allows the client to deploy all the IP to SGT mapping groups.
 Only one Deploy process can run at any given time
+- Waits for the deploy to finish, up to the create timeout, and stores the network devices the mapping groups were deployed to or failed on in item. A deploy that failed on any device is reported as an error and the resource is tainted, so the next apply deploys again.
+- ISE only reports the status of the last deploy, so the result is taken once the deploy is seen in progress or its start or end time, or else the status, differs from the one read before the deploy. An unchanged SUCCESS status with no time to tell the deploys apart is taken after 30 seconds. A deploy rejected because another deploy is running is reported as an error.
 `,
 
 		CreateContext: resourceSgMappingGroupDeployAllCreate,
 		ReadContext:   resourceSgMappingGroupDeployAllRead,
 		DeleteContext: resourceSgMappingGroupDeployAllDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(SG_MAPPING_DEPLOY_TIMEOUT),
+		},
+
+		SchemaVersion:  1,
+		StateUpgraders: resourceSgMappingDeployStateUpgraders(resourceSgMappingGroupDeployAllParametersV0()),
+
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
-			"item": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			"item": sgMappingDeployItemSchema(),
 			"parameters": &schema.Schema{
 				Type:     schema.TypeList,
 				Required: true,
@@ -46,36 +53,21 @@ Only one Deploy process can run at any given time
 
 func resourceSgMappingGroupDeployAllCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning DeployAllIPToSgtMappingGroup create")
-	log.Printf("[DEBUG] Missing DeployAllIPToSgtMappingGroup create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
-	var diags diag.Diagnostics
-	response1, err := client.IPToSgtMappingGroup.DeployAllIPToSgtMappingGroup()
-	if err != nil || response1 == nil {
-		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
-			diags = append(diags, diagErrorWithAltAndResponse(
-				"Failure when executing DeployAllIPToSgtMappingGroup", err, response1.String(),
-				"Failure at DeployAllIPToSgtMappingGroup, unexpected response", ""))
-			return diags
-		}
-		diags = append(diags, diagErrorWithAlt(
-			"Failure when executing DeployAllIPToSgtMappingGroup", err,
-			"Failure at DeployAllIPToSgtMappingGroup, unexpected response", ""))
-		return diags
-	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-	if err := d.Set("item", response1.String()); err != nil {
-		diags = append(diags, diagError(
-			"Failure when setting DeployAllIPToSgtMappingGroup response",
-			err))
+
+	diags := sgMappingDeployCreate(ctx, d, "DeployAllIPToSgtMappingGroup", func() (*resty.Response, error) {
+		return client.IPToSgtMappingGroup.DeployAllIPToSgtMappingGroup()
+	}, sgMappingGroupDeployStatus(client))
+	if d.Id() == "" {
 		return diags
 	}
-	_ = d.Set("last_updated", getUnixTimeString())
+	return append(diags, resourceSgMappingGroupDeployAllRead(ctx, d, m)...)
+}
 
-	d.SetId(getUnixTimeString())
-	return resourceSgMappingGroupDeployAllRead(ctx, d, m)
+func resourceSgMappingGroupDeployAllParametersV0() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
 }
 
 func resourceSgMappingGroupDeployAllRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -29,7 +29,7 @@ func resourceSgToVnToVLAN() *schema.Resource {
 		UpdateContext: resourceSgToVnToVLANUpdate,
 		DeleteContext: resourceSgToVnToVLANDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSgToVnToVLANRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -382,10 +382,9 @@ func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSecurityGroupsToVnToVLAN", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -393,8 +392,7 @@ func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m int
 		items1 := getAllItemsSecurityGroupToVirtualNetworkGetSecurityGroupsToVnToVLAN(m, response1, &queryParams1)
 		item1, err := searchSecurityGroupToVirtualNetworkGetSecurityGroupsToVnToVLAN(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSecurityGroupsToVnToVLAN", err, nil)
 		}
 		vItem1 := flattenSecurityGroupToVirtualNetworkGetSecurityGroupsToVnToVLANByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -419,10 +417,9 @@ func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSecurityGroupsToVnToVLANByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -494,7 +491,7 @@ func resourceSgToVnToVLANUpdate(ctx context.Context, d *schema.ResourceData, m i
 		response1, restyResp1, err := client.SecurityGroupToVirtualNetwork.UpdateSecurityGroupsToVnToVLANByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSecurityGroupsToVnToVLANByID", err, restyResp1.String(),
 					"Failure at UpdateSecurityGroupsToVnToVLANByID, unexpected response", ""))
@@ -562,7 +559,7 @@ func resourceSgToVnToVLANDelete(ctx context.Context, d *schema.ResourceData, m i
 	restyResp1, err := client.SecurityGroupToVirtualNetwork.DeleteSecurityGroupsToVnToVLANByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSecurityGroupsToVnToVLANByID", err, restyResp1.String(),
 				"Failure at DeleteSecurityGroupsToVnToVLANByID, unexpected response", ""))
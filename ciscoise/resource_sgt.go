@@ -22,6 +22,8 @@ func resourceSgt() *schema.Resource {
 - This resource deletes a security group.
 
 - This resource creates a security group.
+
+- When value is not set, the lowest value of value_pool not used by another security group is allocated on create, one resource at a time across the provider. The value is kept afterwards, even when value_pool changes.
 `,
 
 		CreateContext: resourceSgtCreate,
@@ -29,7 +31,7 @@ func resourceSgt() *schema.Resource {
 		UpdateContext: resourceSgtUpdate,
 		DeleteContext: resourceSgtDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSgtRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -162,6 +164,28 @@ func resourceSgt() *schema.Resource {
 							DiffSuppressFunc: diffSupressOptional(),
 							ValidateFunc:     validateIntegerInRange(2, 65519),
 						},
+						"value_pool": &schema.Schema{
+							Description: `Range of values to allocate value from when it is not set`,
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end": &schema.Schema{
+										Description:  `Last value of the pool, included`,
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validateIntegerInRange(2, 65519),
+									},
+									"start": &schema.Schema{
+										Description:  `First value of the pool`,
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validateIntegerInRange(2, 65519),
+									},
+								},
+							},
+						},
 						"link": &schema.Schema{
 							Type:     schema.TypeList,
 							Computed: true,
@@ -238,6 +262,25 @@ func resourceSgtCreate(ctx context.Context, d *schema.ResourceData, m interface{
 			}
 		}
 	}
+	start, end, okPool := expandSgtValuePool(d.Get("parameters.0.value_pool"))
+	if okPool && request1 != nil && request1.Sgt != nil && request1.Sgt.Value == nil {
+		sgtValuePoolMutexKV.Lock(sgtValuePoolKey)
+		defer sgtValuePoolMutexKV.Unlock(sgtValuePoolKey)
+		used, err := readSgtValues(m)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when allocating Sgt value", err))
+			return diags
+		}
+		value, err := lowestFreeSgtValue(used, start, end)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when allocating Sgt value", err))
+			return diags
+		}
+		log.Printf("[DEBUG] Allocated Sgt value %d from value_pool %d-%d", value, start, end)
+		request1.Sgt.Value = &value
+	}
 	restyResp1, err := client.SecurityGroups.CreateSecurityGroup(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -287,10 +330,9 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSecurityGroups", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -298,10 +340,10 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		items1 := getAllItemsSecurityGroupsGetSecurityGroups(m, response1, &queryParams1)
 		item1, err := searchSecurityGroupsGetSecurityGroups(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSecurityGroups", err, nil)
 		}
 		vItem1 := flattenSecurityGroupsGetSecurityGroupByIDItem(item1)
+		flattenSgtValuePool(d, vItem1)
 		if err := d.Set("parameters", vItem1); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroups search response",
@@ -331,10 +373,9 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSecurityGroupByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -346,6 +387,7 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 				err))
 			return diags
 		}
+		flattenSgtValuePool(d, vItem2)
 		if err := d.Set("parameters", vItem2); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroups search response",
@@ -412,7 +454,7 @@ func resourceSgtUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		response1, restyResp1, err := client.SecurityGroups.UpdateSecurityGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSecurityGroupByID", err, restyResp1.String(),
 					"Failure at UpdateSecurityGroupByID, unexpected response", ""))
@@ -480,7 +522,7 @@ func resourceSgtDelete(ctx context.Context, d *schema.ResourceData, m interface{
 	restyResp1, err := client.SecurityGroups.DeleteSecurityGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSecurityGroupByID", err, restyResp1.String(),
 				"Failure at DeleteSecurityGroupByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceSponsorGroup() *schema.Resource {
 		UpdateContext: resourceSponsorGroupUpdate,
 		DeleteContext: resourceSponsorGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSponsorGroupRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -576,10 +576,9 @@ func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSponsorGroup", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -587,8 +586,7 @@ func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m int
 		items1 := getAllItemsSponsorGroupGetSponsorGroup(m, response1, &queryParams1)
 		item1, err := searchSponsorGroupGetSponsorGroup(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSponsorGroup", err, nil)
 		}
 		vItem1 := flattenSponsorGroupGetSponsorGroupByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -613,10 +611,9 @@ func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSponsorGroupByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -688,7 +685,7 @@ func resourceSponsorGroupUpdate(ctx context.Context, d *schema.ResourceData, m i
 		response1, restyResp1, err := client.SponsorGroup.UpdateSponsorGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSponsorGroupByID", err, restyResp1.String(),
 					"Failure at UpdateSponsorGroupByID, unexpected response", ""))
@@ -756,7 +753,7 @@ func resourceSponsorGroupDelete(ctx context.Context, d *schema.ResourceData, m i
 	restyResp1, err := client.SponsorGroup.DeleteSponsorGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSponsorGroupByID", err, restyResp1.String(),
 				"Failure at DeleteSponsorGroupByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceSponsorPortal() *schema.Resource {
 		UpdateContext: resourceSponsorPortalUpdate,
 		DeleteContext: resourceSponsorPortalDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSponsorPortalRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1275,10 +1275,9 @@ func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSponsorPortal", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1286,8 +1285,7 @@ func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m in
 		items1 := getAllItemsSponsorPortalGetSponsorPortal(m, response1, &queryParams1)
 		item1, err := searchSponsorPortalGetSponsorPortal(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSponsorPortal", err, nil)
 		}
 		vItem1 := flattenSponsorPortalGetSponsorPortalByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -1312,10 +1310,9 @@ func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSponsorPortalByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1387,7 +1384,7 @@ func resourceSponsorPortalUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.SponsorPortal.UpdateSponsorPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSponsorPortalByID", err, restyResp1.String(),
 					"Failure at UpdateSponsorPortalByID, unexpected response", ""))
@@ -1455,7 +1452,7 @@ func resourceSponsorPortalDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.SponsorPortal.DeleteSponsorPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSponsorPortalByID", err, restyResp1.String(),
 				"Failure at DeleteSponsorPortalByID, unexpected response", ""))
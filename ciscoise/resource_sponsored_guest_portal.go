@@ -29,7 +29,7 @@ func resourceSponsoredGuestPortal() *schema.Resource {
 		UpdateContext: resourceSponsoredGuestPortalUpdate,
 		DeleteContext: resourceSponsoredGuestPortalDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceSponsoredGuestPortalRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1709,10 +1709,9 @@ func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSponsoredGuestPortals", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -1720,8 +1719,7 @@ func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceDat
 		items1 := getAllItemsSponsoredGuestPortalGetSponsoredGuestPortals(m, response1, &queryParams1)
 		item1, err := searchSponsoredGuestPortalGetSponsoredGuestPortals(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSponsoredGuestPortals", err, nil)
 		}
 		vItem1 := flattenSponsoredGuestPortalGetSponsoredGuestPortalByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -1746,10 +1744,9 @@ func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSponsoredGuestPortalByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -1822,7 +1819,7 @@ func resourceSponsoredGuestPortalUpdate(ctx context.Context, d *schema.ResourceD
 		response1, restyResp1, err := client.SponsoredGuestPortal.UpdateSponsoredGuestPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSponsoredGuestPortalByID", err, restyResp1.String(),
 					"Failure at UpdateSponsoredGuestPortalByID, unexpected response", ""))
@@ -1890,7 +1887,7 @@ func resourceSponsoredGuestPortalDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.SponsoredGuestPortal.DeleteSponsoredGuestPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSponsoredGuestPortalByID", err, restyResp1.String(),
 				"Failure at DeleteSponsoredGuestPortalByID, unexpected response", ""))
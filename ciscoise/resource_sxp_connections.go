@@ -283,10 +283,9 @@ func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSxpConnections", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -294,8 +293,7 @@ func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m i
 		items1 := getAllItemsSxpConnectionsGetSxpConnections(m, response1, &queryParams1)
 		item1, err := searchSxpConnectionsGetSxpConnections(m, items1, "", vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSxpConnections", err, nil)
 		}
 		vItem1 := flattenSxpConnectionsGetSxpConnectionsByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -320,10 +318,9 @@ func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSxpConnectionsByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -379,7 +376,7 @@ func resourceSxpConnectionsUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.SxpConnections.UpdateSxpConnectionsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSxpConnectionsByID", err, restyResp1.String(),
 					"Failure at UpdateSxpConnectionsByID, unexpected response", ""))
@@ -446,7 +443,7 @@ func resourceSxpConnectionsDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.SxpConnections.DeleteSxpConnectionsByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSxpConnectionsByID", err, restyResp1.String(),
 				"Failure at DeleteSxpConnectionsByID, unexpected response", ""))
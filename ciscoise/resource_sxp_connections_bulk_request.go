@@ -2,12 +2,9 @@ package ciscoise
 
 import (
 	"context"
-	"reflect"
-
 	"log"
 
-	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
-
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -16,76 +13,38 @@ func resourceSxpConnectionsBulkRequest() *schema.Resource {
 	return &schema.Resource{
 		Description: `It performs update operation on SXPConnections.
 - This resource allows the client to submit the bulk request.
+- It waits until the bulk request finishes and stores the status of each resource in item.
 `,
 
 		CreateContext: resourceSxpConnectionsBulkRequestCreate,
 		ReadContext:   resourceSxpConnectionsBulkRequestRead,
 		DeleteContext: resourceSxpConnectionsBulkRequestDelete,
 
-		Schema: map[string]*schema.Schema{
-			"last_updated": &schema.Schema{
-				Description: `Unix timestamp records the last time that the resource was updated.`,
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"item": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"parameters": &schema.Schema{
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
-				MinItems: 1,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"operation_type": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-						"resource_media_type": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-					},
-				},
-			},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(BULK_REQUEST_TIMEOUT),
 		},
+
+		SchemaVersion:  1,
+		StateUpgraders: resourceBulkRequestStateUpgraders(),
+
+		Schema: resourceBulkRequestSchema(resourceBulkRequestParametersElem(resourceSxpConnections()), ersBulkOperationTypes),
 	}
 }
 
 func resourceSxpConnectionsBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Beginning BulkRequestForSxpConnections create")
-	log.Printf("[DEBUG] Missing BulkRequestForSxpConnections create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	var diags diag.Diagnostics
 	request1 := expandRequestSxpConnectionsBulkRequestBulkRequestForSxpConnections(ctx, "parameters.0", d)
-
-	response1, err := client.SxpConnections.BulkRequestForSxpConnections(request1)
-	if err != nil || response1 == nil {
-		diags = append(diags, diagErrorWithAlt(
-			"Failure when executing BulkRequestForSxpConnections", err,
-			"Failure at BulkRequestForSxpConnections, unexpected response", ""))
-		return diags
-	}
-
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-
-	if err := d.Set("item", response1.String()); err != nil {
-		diags = append(diags, diagError(
-			"Failure when setting BulkRequestForSxpConnections response",
-			err))
+	diags := executeBulkRequest(ctx, d, m, "BulkRequestForSxpConnections", "/ers/config/sxpconnections/bulk/submit", "ConnectionBulkRequest", request1,
+		func(bulkID string) (interface{}, *resty.Response, error) {
+			return client.SxpConnections.MonitorBulkStatusSxpConnections(bulkID)
+		})
+	if diags.HasError() {
 		return diags
 	}
-	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-	d.SetId(getUnixTimeString())
-	return resourceSxpConnectionsBulkRequestRead(ctx, d, m)
+	return append(diags, resourceSxpConnectionsBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceSxpConnectionsBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -100,19 +59,13 @@ func resourceSxpConnectionsBulkRequestDelete(ctx context.Context, d *schema.Reso
 	return diags
 }
 
-func expandRequestSxpConnectionsBulkRequestBulkRequestForSxpConnections(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestSxpConnectionsBulkRequestForSxpConnections {
-	request := isegosdk.RequestSxpConnectionsBulkRequestForSxpConnections{}
-	request.ConnectionBulkRequest = expandRequestSxpConnectionsBulkRequestBulkRequestForSxpConnectionsConnectionBulkRequest(ctx, key, d)
-	return &request
-}
-
-func expandRequestSxpConnectionsBulkRequestBulkRequestForSxpConnectionsConnectionBulkRequest(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestSxpConnectionsBulkRequestForSxpConnectionsConnectionBulkRequest {
-	request := isegosdk.RequestSxpConnectionsBulkRequestForSxpConnectionsConnectionBulkRequest{}
-	if v, ok := d.GetOkExists(fixKeyAccess(key + ".operation_type")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".operation_type")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".operation_type")))) {
-		request.OperationType = interfaceToString(v)
-	}
-	if v, ok := d.GetOkExists(fixKeyAccess(key + ".resource_media_type")); !isEmptyValue(reflect.ValueOf(d.Get(fixKeyAccess(key+".resource_media_type")))) && (ok || !reflect.DeepEqual(v, d.Get(fixKeyAccess(key+".resource_media_type")))) {
-		request.ResourceMediaType = interfaceToString(v)
-	}
-	return &request
+func expandRequestSxpConnectionsBulkRequestBulkRequestForSxpConnections(ctx context.Context, key string, d *schema.ResourceData) *ersBulkRequest {
+	return expandRequestBulkRequest(ctx, key, d,
+		func(ctx context.Context, key string, d *schema.ResourceData) interface{} {
+			return expandRequestSxpConnectionsCreateSxpConnections(ctx, key, d)
+		},
+		func(ctx context.Context, key string, d *schema.ResourceData) interface{} {
+			return expandRequestSxpConnectionsUpdateSxpConnectionsByID(ctx, key, d)
+		},
+	)
 }
@@ -272,10 +272,9 @@ func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSxpLocalBindings", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -283,8 +282,7 @@ func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m
 		items1 := getAllItemsSxpLocalBindingsGetSxpLocalBindings(m, response1, &queryParams1)
 		item1, err := searchSxpLocalBindingsGetSxpLocalBindings(m, items1, "", vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSxpLocalBindings", err, nil)
 		}
 		vItem1 := flattenSxpLocalBindingsGetSxpLocalBindingsByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -309,10 +307,9 @@ func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSxpLocalBindingsByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -367,7 +364,7 @@ func resourceSxpLocalBindingsUpdate(ctx context.Context, d *schema.ResourceData,
 		response1, restyResp1, err := client.SxpLocalBindings.UpdateSxpLocalBindingsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSxpLocalBindingsByID", err, restyResp1.String(),
 					"Failure at UpdateSxpLocalBindingsByID, unexpected response", ""))
@@ -434,7 +431,7 @@ func resourceSxpLocalBindingsDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.SxpLocalBindings.DeleteSxpLocalBindingsByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSxpLocalBindingsByID", err, restyResp1.String(),
 				"Failure at DeleteSxpLocalBindingsByID, unexpected response", ""))
@@ -217,10 +217,9 @@ func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSxpVpns", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -228,8 +227,7 @@ func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interfac
 		items1 := getAllItemsSxpVpnsGetSxpVpns(m, response1, &queryParams1)
 		item1, err := searchSxpVpnsGetSxpVpns(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSxpVpns", err, nil)
 		}
 		vItem1 := flattenSxpVpnsGetSxpVpnByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -254,10 +252,9 @@ func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSxpVpnByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -339,7 +336,7 @@ func resourceSxpVpnsDelete(ctx context.Context, d *schema.ResourceData, m interf
 	restyResp1, err := client.SxpVpns.DeleteSxpVpnByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSxpVpnByID", err, restyResp1.String(),
 				"Failure at DeleteSxpVpnByID, unexpected response", ""))
@@ -387,7 +387,7 @@ func resourceSystemCertificateCreate(ctx context.Context, d *schema.ResourceData
 	response1, restyResp1, err := client.Certificates.UpdateSystemCert(vvID, vvHostName, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateSystemCert", err, restyResp1.String(),
 				"Failure at UpdateSystemCert, unexpected response", ""))
@@ -435,10 +435,9 @@ func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSystemCertificates", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -446,8 +445,7 @@ func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData,
 		items1 := getAllItemsCertificatesGetSystemCertificates(m, response1, vvHostName, &queryParams1)
 		item1, err := searchCertificatesGetSystemCertificates(m, items1, vvName, vvID, vvHostName)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSystemCertificates", err, nil)
 		}
 		vItem1 := flattenCertificatesGetSystemCertificateByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -471,10 +469,9 @@ func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSystemCertificateByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -535,7 +532,7 @@ func resourceSystemCertificateUpdate(ctx context.Context, d *schema.ResourceData
 		response1, restyResp1, err := client.Certificates.UpdateSystemCert(vvID, vvHostName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSystemCert", err, restyResp1.String(),
 					"Failure at UpdateSystemCert, unexpected response", ""))
@@ -584,7 +581,7 @@ func resourceSystemCertificateDelete(ctx context.Context, d *schema.ResourceData
 	response1, restyResp1, err := client.Certificates.DeleteSystemCertificateByID(vvHostName, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSystemCertificateByID", err, restyResp1.String(),
 				"Failure at DeleteSystemCertificateByID, unexpected response", ""))
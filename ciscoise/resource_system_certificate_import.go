@@ -179,6 +179,7 @@ Request parameters accepting True and False as input can be replaced by 1 and 0
 						"private_key_data": &schema.Schema{
 							Description: `Private Key data (required)`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							ForceNew:    true,
 						},
@@ -232,7 +233,7 @@ func resourceSystemCertificateImportCreate(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.Certificates.ImportSystemCert(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ImportSystemCert", err, restyResp1.String(),
 				"Failure at ImportSystemCert, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceTacacsCommandSets() *schema.Resource {
 		UpdateContext: resourceTacacsCommandSetsUpdate,
 		DeleteContext: resourceTacacsCommandSetsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceTacacsCommandSetsRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -305,10 +305,9 @@ func resourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTacacsCommandSetsByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -343,10 +342,9 @@ func resourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTacacsCommandSetsByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -422,7 +420,7 @@ func resourceTacacsCommandSetsUpdate(ctx context.Context, d *schema.ResourceData
 		response1, restyResp1, err := client.TacacsCommandSets.UpdateTacacsCommandSetsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTacacsCommandSetsByID", err, restyResp1.String(),
 					"Failure at UpdateTacacsCommandSetsByID, unexpected response", ""))
@@ -482,7 +480,7 @@ func resourceTacacsCommandSetsDelete(ctx context.Context, d *schema.ResourceData
 	restyResp1, err := client.TacacsCommandSets.DeleteTacacsCommandSetsByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTacacsCommandSetsByID", err, restyResp1.String(),
 				"Failure at DeleteTacacsCommandSetsByID, unexpected response", ""))
@@ -28,7 +28,7 @@ func resourceTacacsExternalServers() *schema.Resource {
 		UpdateContext: resourceTacacsExternalServersUpdate,
 		DeleteContext: resourceTacacsExternalServersDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceTacacsExternalServersRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,6 +89,7 @@ func resourceTacacsExternalServers() *schema.Resource {
 						"shared_secret": &schema.Schema{
 							Description: `The server shared secret`,
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 						},
 						"single_connect": &schema.Schema{
@@ -168,6 +169,7 @@ func resourceTacacsExternalServers() *schema.Resource {
 						"shared_secret": &schema.Schema{
 							Description:      `The server shared secret`,
 							Type:             schema.TypeString,
+							Sensitive:        true,
 							Optional:         true,
 							DiffSuppressFunc: diffSupressOptional(),
 							Computed:         true,
@@ -282,10 +284,9 @@ func resourceTacacsExternalServersRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTacacsExternalServersByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -314,10 +315,9 @@ func resourceTacacsExternalServersRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTacacsExternalServersByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -387,7 +387,7 @@ func resourceTacacsExternalServersUpdate(ctx context.Context, d *schema.Resource
 		response1, restyResp1, err := client.TacacsExternalServers.UpdateTacacsExternalServersByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTacacsExternalServersByID", err, restyResp1.String(),
 					"Failure at UpdateTacacsExternalServersByID, unexpected response", ""))
@@ -447,7 +447,7 @@ func resourceTacacsExternalServersDelete(ctx context.Context, d *schema.Resource
 	restyResp1, err := client.TacacsExternalServers.DeleteTacacsExternalServersByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTacacsExternalServersByID", err, restyResp1.String(),
 				"Failure at DeleteTacacsExternalServersByID, unexpected response", ""))
@@ -29,7 +29,7 @@ func resourceTacacsProfile() *schema.Resource {
 		UpdateContext: resourceTacacsProfileUpdate,
 		DeleteContext: resourceTacacsProfileDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceTacacsProfileRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -296,10 +296,9 @@ func resourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTacacsProfileByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -328,10 +327,9 @@ func resourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTacacsProfileByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -401,7 +399,7 @@ func resourceTacacsProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 		response1, restyResp1, err := client.TacacsProfile.UpdateTacacsProfileByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTacacsProfileByID", err, restyResp1.String(),
 					"Failure at UpdateTacacsProfileByID, unexpected response", ""))
@@ -461,7 +459,7 @@ func resourceTacacsProfileDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.TacacsProfile.DeleteTacacsProfileByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTacacsProfileByID", err, restyResp1.String(),
 				"Failure at DeleteTacacsProfileByID, unexpected response", ""))
@@ -28,7 +28,7 @@ func resourceTacacsServerSequence() *schema.Resource {
 		UpdateContext: resourceTacacsServerSequenceUpdate,
 		DeleteContext: resourceTacacsServerSequenceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceTacacsServerSequenceRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -307,10 +307,9 @@ func resourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTacacsServerSequenceByName", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -339,10 +338,9 @@ func resourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTacacsServerSequenceByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -412,7 +410,7 @@ func resourceTacacsServerSequenceUpdate(ctx context.Context, d *schema.ResourceD
 		response1, restyResp1, err := client.TacacsServerSequence.UpdateTacacsServerSequenceByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTacacsServerSequenceByID", err, restyResp1.String(),
 					"Failure at UpdateTacacsServerSequenceByID, unexpected response", ""))
@@ -472,7 +470,7 @@ func resourceTacacsServerSequenceDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.TacacsServerSequence.DeleteTacacsServerSequenceByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTacacsServerSequenceByID", err, restyResp1.String(),
 				"Failure at DeleteTacacsServerSequenceByID, unexpected response", ""))
@@ -64,7 +64,7 @@ func resourceThreatVulnerabilitiesClearCreate(ctx context.Context, d *schema.Res
 	response1, err := client.ClearThreatsAndVulnerabilities.ClearThreatsAndVulnerabilities(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ClearThreatsAndVulnerabilities", err, response1.String(),
 				"Failure at ClearThreatsAndVulnerabilities, unexpected response", ""))
@@ -75,7 +75,7 @@ func resourceThreatVulnerabilitiesClearCreate(ctx context.Context, d *schema.Res
 			"Failure at ClearThreatsAndVulnerabilities, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting ClearThreatsAndVulnerabilities response",
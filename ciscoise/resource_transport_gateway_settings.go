@@ -98,7 +98,7 @@ func resourceTransportGatewaySettingsCreate(ctx context.Context, d *schema.Resou
 	response1, restyResp1, err := client.Telemetry.UpdateTransportGateway(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateTransportGateway", err, restyResp1.String(),
 				"Failure at UpdateTransportGateway, unexpected response", ""))
@@ -129,10 +129,9 @@ func resourceTransportGatewaySettingsRead(ctx context.Context, d *schema.Resourc
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetTransportGateway", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -171,7 +170,7 @@ func resourceTransportGatewaySettingsUpdate(ctx context.Context, d *schema.Resou
 		response1, restyResp1, err := client.Telemetry.UpdateTransportGateway(request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTransportGateway", err, restyResp1.String(),
 					"Failure at UpdateTransportGateway, unexpected response", ""))
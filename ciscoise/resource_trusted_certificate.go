@@ -514,7 +514,7 @@ func resourceTrustedCertificateCreate(ctx context.Context, d *schema.ResourceDat
 	response1, restyResp1, err := client.Certificates.UpdateTrustedCertificate(vvID, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateTrustedCertificate", err, restyResp1.String(),
 				"Failure at UpdateTrustedCertificate, unexpected response", ""))
@@ -551,10 +551,9 @@ func resourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceData,
 
 	if err != nil || response2 == nil {
 		if restyResp2 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 		}
-		d.SetId("")
-		return diags
+		return diagReadError(d, "Failure when executing GetTrustedCertificateByID", err, restyResp2)
 	}
 
 	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -595,7 +594,7 @@ func resourceTrustedCertificateUpdate(ctx context.Context, d *schema.ResourceDat
 		response1, restyResp1, err := client.Certificates.UpdateTrustedCertificate(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTrustedCertificate", err, restyResp1.String(),
 					"Failure at UpdateTrustedCertificate, unexpected response", ""))
@@ -634,7 +633,7 @@ func resourceTrustedCertificateDelete(ctx context.Context, d *schema.ResourceDat
 	response1, restyResp1, err := client.Certificates.DeleteTrustedCertificateByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTrustedCertificateByID", err, restyResp1.String(),
 				"Failure at DeleteTrustedCertificateByID, unexpected response", ""))
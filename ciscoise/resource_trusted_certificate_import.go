@@ -157,7 +157,7 @@ func resourceTrustedCertificateImportCreate(ctx context.Context, d *schema.Resou
 	response1, restyResp1, err := client.Certificates.ImportTrustCert(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ImportTrustCert", err,
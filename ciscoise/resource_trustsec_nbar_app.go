@@ -29,7 +29,7 @@ func resourceTrustsecNbarApp() *schema.Resource {
 		UpdateContext: resourceTrustsecNbarAppUpdate,
 		DeleteContext: resourceTrustsecNbarAppDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceTrustsecNbarAppRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -201,10 +201,9 @@ func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNbarApps", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -212,8 +211,7 @@ func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m
 		items1 := getAllItemsNbarAppGetNbarApps(m, response1, nil)
 		item1, err := searchNbarAppGetNbarApps(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetNbarApps", err, nil)
 		}
 		vItem1 := flattenNbarAppGetNbarAppByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -238,10 +236,9 @@ func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetNbarAppByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -317,7 +314,7 @@ func resourceTrustsecNbarAppUpdate(ctx context.Context, d *schema.ResourceData,
 		response1, restyResp1, err := client.NbarApp.UpdateNbarAppByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNbarAppByID", err, restyResp1.String(),
 					"Failure at UpdateNbarAppByID, unexpected response", ""))
@@ -390,7 +387,7 @@ func resourceTrustsecNbarAppDelete(ctx context.Context, d *schema.ResourceData,
 	response1, restyResp1, err := client.NbarApp.DeleteNbarAppByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNbarAppByID", err, restyResp1.String(),
 				"Failure at DeleteNbarAppByID, unexpected response", ""))
@@ -245,10 +245,9 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 		response1, restyResp1, err := client.SgVnMapping.GetSgVnMappings(&queryParams1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSgVnMappings", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -256,8 +255,7 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 		items1 := getAllItemsSgVnMappingGetSgVnMappings(m, response1, nil)
 		item1, err := searchSgVnMappingGetSgVnMappings(m, items1, vvSgName, vvSgtID, vvVnID, vvVnName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetSgVnMappings", err, nil)
 		}
 		vItem1 := flattenSgVnMappingGetSgVnMappingByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -282,10 +280,9 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetSgVnMappingByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -365,7 +362,7 @@ func resourceTrustsecSgVnMappingUpdate(ctx context.Context, d *schema.ResourceDa
 		response1, restyResp1, err := client.SgVnMapping.UpdateSgVnMappingByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSgVnMappingByID", err, restyResp1.String(),
 					"Failure at UpdateSgVnMappingByID, unexpected response", ""))
@@ -446,7 +443,7 @@ func resourceTrustsecSgVnMappingDelete(ctx context.Context, d *schema.ResourceDa
 	response1, restyResp1, err := client.SgVnMapping.DeleteSgVnMappingByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSgVnMappingByID", err, restyResp1.String(),
 				"Failure at DeleteSgVnMappingByID, unexpected response", ""))
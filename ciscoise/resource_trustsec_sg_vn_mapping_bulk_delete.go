@@ -77,7 +77,7 @@ func resourceTrustsecSgVnMappingBulkDeleteCreate(ctx context.Context, d *schema.
 	response1, restyResp1, err := client.SgVnMapping.BulkDeleteSgVnMappings(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkDeleteSgVnMappings", err,
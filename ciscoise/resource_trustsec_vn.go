@@ -29,7 +29,7 @@ func resourceTrustsecVn() *schema.Resource {
 		UpdateContext: resourceTrustsecVnUpdate,
 		DeleteContext: resourceTrustsecVnDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateByIDOrName(resourceTrustsecVnRead),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -196,10 +196,9 @@ func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m inter
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetVirtualNetworks", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -207,8 +206,7 @@ func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m inter
 		items1 := getAllItemsVirtualNetworkGetVirtualNetworks(m, response1, nil)
 		item1, err := searchVirtualNetworkGetVirtualNetworks(m, items1, vvName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetVirtualNetworks", err, nil)
 		}
 		vItem1 := flattenVirtualNetworkGetVirtualNetworkByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -231,10 +229,9 @@ func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m inter
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetVirtualNetworkByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -305,7 +302,7 @@ func resourceTrustsecVnUpdate(ctx context.Context, d *schema.ResourceData, m int
 		response1, restyResp1, err := client.VirtualNetwork.UpdateVirtualNetworkByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateVirtualNetworkByID", err, restyResp1.String(),
 					"Failure at UpdateVirtualNetworkByID, unexpected response", ""))
@@ -377,7 +374,7 @@ func resourceTrustsecVnDelete(ctx context.Context, d *schema.ResourceData, m int
 	response1, restyResp1, err := client.VirtualNetwork.DeleteVirtualNetworkByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteVirtualNetworkByID", err, restyResp1.String(),
 				"Failure at DeleteVirtualNetworkByID, unexpected response", ""))
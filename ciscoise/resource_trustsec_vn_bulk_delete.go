@@ -77,7 +77,7 @@ func resourceTrustsecVnBulkDeleteCreate(ctx context.Context, d *schema.ResourceD
 	response1, restyResp1, err := client.VirtualNetwork.BulkDeleteVirtualNetworks(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkDeleteVirtualNetworks", err,
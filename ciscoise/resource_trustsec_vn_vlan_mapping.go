@@ -264,10 +264,9 @@ func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetVnVLANMappings", err, restyResp1)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
@@ -275,8 +274,7 @@ func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceDa
 		items1 := getAllItemsVnVLANMappingGetVnVLANMappings(m, response1, nil)
 		item1, err := searchVnVLANMappingGetVnVLANMappings(m, items1, vvName, vvVnID, vvVnName, vvID)
 		if err != nil || item1 == nil {
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when searching GetVnVLANMappings", err, nil)
 		}
 		vItem1 := flattenVnVLANMappingGetVnVLANMappingByIDItem(item1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -301,10 +299,9 @@ func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp2.String()))
 			}
-			d.SetId("")
-			return diags
+			return diagReadError(d, "Failure when executing GetVnVLANMappingByID", err, restyResp2)
 		}
 
 		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
@@ -378,7 +375,7 @@ func resourceTrustsecVnVLANMappingUpdate(ctx context.Context, d *schema.Resource
 		response1, restyResp1, err := client.VnVLANMapping.UpdateVnVLANMappingByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				log.Printf("[DEBUG] resty response for update operation => %v", redactSecrets(restyResp1.String()))
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateVnVLANMappingByID", err, restyResp1.String(),
 					"Failure at UpdateVnVLANMappingByID, unexpected response", ""))
@@ -449,7 +446,7 @@ func resourceTrustsecVnVLANMappingDelete(ctx context.Context, d *schema.Resource
 	response1, restyResp1, err := client.VnVLANMapping.DeleteVnVLANMappingByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			log.Printf("[DEBUG] resty response for delete operation => %v", redactSecrets(restyResp1.String()))
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteVnVLANMappingByID", err, restyResp1.String(),
 				"Failure at DeleteVnVLANMappingByID, unexpected response", ""))
@@ -129,7 +129,7 @@ func resourceTrustsecVnVLANMappingBulkUpdateCreate(ctx context.Context, d *schem
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp1.String()))
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkUpdateVnVLANMappings", err,
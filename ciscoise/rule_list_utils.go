@@ -0,0 +1,600 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+// ruleList describes the authorization rules of a kind of policy set, managed
+// as one ordered list by the *_authorization_rule_list resources.
+type ruleList struct {
+	// operation names the rules in logs and errors, such as
+	// NetworkAccessAuthorizationRule.
+	operation string
+	// path is the rules endpoint, with %s for the policy set id.
+	path string
+	// conditions resolves the library conditions referenced by name.
+	conditions conditionJSON
+	// results are the attributes of the rule result.
+	results []ruleListResult
+	list    func(client *isegosdk.Client, policyID string) (*resty.Response, error)
+	delete  func(client *isegosdk.Client, policyID string, id string) (*resty.Response, error)
+}
+
+// ruleListResult maps a rule result attribute to its API key.
+type ruleListResult struct {
+	key    string
+	apiKey string
+	schema *schema.Schema
+}
+
+// ruleListEntry is a rule as returned by ISE, kept as raw JSON so condition
+// trees of any depth are preserved.
+type ruleListEntry map[string]interface{}
+
+func (e ruleListEntry) rule() map[string]interface{} {
+	rule, _ := e["rule"].(map[string]interface{})
+	if rule == nil {
+		return map[string]interface{}{}
+	}
+	return rule
+}
+
+func (e ruleListEntry) id() string {
+	return conditionString(e.rule(), "id")
+}
+
+func (e ruleListEntry) name() string {
+	return conditionString(e.rule(), "name")
+}
+
+func (e ruleListEntry) rank() int {
+	if rank, ok := e.rule()["rank"].(float64); ok {
+		return int(rank)
+	}
+	return 0
+}
+
+func (e ruleListEntry) isDefault() bool {
+	return e.rule()["default"] == true
+}
+
+// ruleSchema returns the schema of a listed rule.
+func (l ruleList) ruleSchema() map[string]*schema.Schema {
+	ruleSchema := map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Description: `Rule name, unique in the policy set`,
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"state": &schema.Schema{
+			Description:  `The state that the rule is in. A disabled rule cannot be matched.`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateStringHasValueFunc([]string{"enabled", "disabled", "monitor"}),
+		},
+		"condition_expression": resourceConditionExpressionSchema(),
+		"condition_json":       resourceConditionJSONSchema(),
+		"condition_name":       resourceConditionNameSchema(),
+	}
+	for _, result := range l.results {
+		ruleSchema[result.key] = result.schema
+	}
+	return ruleSchema
+}
+
+func (l ruleList) resourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"last_updated": &schema.Schema{
+			Description: `Unix timestamp records the last time that the resource was updated.`,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"item": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policy_id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"rules": &schema.Schema{
+						Description: `Managed rules, in rank order`,
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"id": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"name": &schema.Schema{
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"rank": &schema.Schema{
+									Type:     schema.TypeInt,
+									Computed: true,
+								},
+							},
+						},
+					},
+					"unmanaged_rules": &schema.Schema{
+						Description: `Names of the rules of the policy set that are not in the list, except the default rule`,
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"parameters": &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policy_id": &schema.Schema{
+						Description: `Policy set id`,
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+					},
+					"rules": &schema.Schema{
+						Description: `Rules in priority order. The rank of each rule is its position in the list, so the list is placed at the top of the policy set.`,
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Elem: &schema.Resource{
+							Schema: l.ruleSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (l ruleList) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning %sList create", l.operation)
+	policyID := interfaceToString(d.Get("parameters.0.policy_id"))
+	policySetMutexKV.Lock(policyID)
+	defer policySetMutexKV.Unlock(policyID)
+	diags := l.apply(ctx, d, m, policyID, "parameters.0.rules", nil)
+	if diags.HasError() {
+		return diags
+	}
+	resourceMap := make(map[string]string)
+	resourceMap["policy_id"] = policyID
+	d.SetId(joinResourceID(resourceMap))
+	return append(diags, l.read(ctx, d, m)...)
+}
+
+func (l ruleList) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+	var diags diag.Diagnostics
+
+	resourceMap := separateResourceID(d.Id())
+	policyID := resourceMap["policy_id"]
+	log.Printf("[DEBUG] Beginning %sList read for id=[%s]", l.operation, d.Id())
+
+	entries, restyResp, err := l.entries(client, policyID)
+	if err != nil {
+		return diagReadError(d, fmt.Sprintf("Failure when executing Get%ss", l.operation), err, restyResp)
+	}
+
+	prior := map[string]map[string]interface{}{}
+	if rules, ok := d.Get("parameters.0.rules").([]interface{}); ok {
+		for _, v := range rules {
+			if rule, ok := v.(map[string]interface{}); ok {
+				prior[interfaceToString(rule["name"])] = rule
+			}
+		}
+	}
+	vRules := []map[string]interface{}{}
+	vItemRules := []map[string]interface{}{}
+	unmanaged := []string{}
+	for _, entry := range entries {
+		priorRule, managed := prior[entry.name()]
+		if len(prior) > 0 && !managed {
+			unmanaged = append(unmanaged, entry.name())
+			continue
+		}
+		vRules = append(vRules, l.flattenEntry(entry, priorRule))
+		vItemRules = append(vItemRules, map[string]interface{}{
+			"id":   entry.id(),
+			"name": entry.name(),
+			"rank": entry.rank(),
+		})
+	}
+	vItem := []map[string]interface{}{
+		{
+			"policy_id":       policyID,
+			"rules":           vItemRules,
+			"unmanaged_rules": unmanaged,
+		},
+	}
+	if err := d.Set("item", vItem); err != nil {
+		diags = append(diags, diagError(
+			fmt.Sprintf("Failure when setting Get%ss response", l.operation),
+			err))
+		return diags
+	}
+	vParameters := []map[string]interface{}{
+		{
+			"policy_id": policyID,
+			"rules":     vRules,
+		},
+	}
+	if err := d.Set("parameters", vParameters); err != nil {
+		diags = append(diags, diagError(
+			fmt.Sprintf("Failure when setting Get%ss response to parameters", l.operation),
+			err))
+		return diags
+	}
+	return diags
+}
+
+func (l ruleList) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning %sList update for id=[%s]", l.operation, d.Id())
+	resourceMap := separateResourceID(d.Id())
+	policySetMutexKV.Lock(resourceMap["policy_id"])
+	defer policySetMutexKV.Unlock(resourceMap["policy_id"])
+	if d.HasChange("parameters") {
+		oldRules, _ := d.GetChange("parameters.0.rules")
+		removed := []string{}
+		if rules, ok := oldRules.([]interface{}); ok {
+			for _, v := range rules {
+				if rule, ok := v.(map[string]interface{}); ok {
+					removed = append(removed, interfaceToString(rule["name"]))
+				}
+			}
+		}
+		diags := l.apply(ctx, d, m, resourceMap["policy_id"], "parameters.0.rules", removed)
+		if diags.HasError() {
+			return diags
+		}
+		_ = d.Set("last_updated", getUnixTimeString())
+		return append(diags, l.read(ctx, d, m)...)
+	}
+	return l.read(ctx, d, m)
+}
+
+func (l ruleList) remove(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Beginning %sList delete for id=[%s]", l.operation, d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+	var diags diag.Diagnostics
+
+	resourceMap := separateResourceID(d.Id())
+	policyID := resourceMap["policy_id"]
+	policySetMutexKV.Lock(policyID)
+	defer policySetMutexKV.Unlock(policyID)
+	entries, restyResp, err := l.entries(client, policyID)
+	if err != nil {
+		if isNotFoundResponse(restyResp) {
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diagErrorWithOptionalResponse(fmt.Sprintf("Failure when executing Get%ss", l.operation), err, restyResp))
+		return diags
+	}
+	managed := map[string]bool{}
+	if rules, ok := d.Get("item.0.rules").([]interface{}); ok {
+		for _, v := range rules {
+			if rule, ok := v.(map[string]interface{}); ok {
+				managed[interfaceToString(rule["id"])] = true
+			}
+		}
+	}
+	for _, entry := range entries {
+		if !managed[entry.id()] {
+			continue
+		}
+		if restyResp, err := l.delete(client, policyID, entry.id()); err != nil {
+			diags = append(diags, diagErrorWithOptionalResponse(fmt.Sprintf("Failure when executing Delete%sByID", l.operation), err, restyResp))
+			return diags
+		}
+	}
+	d.SetId("")
+	return diags
+}
+
+// importState accepts the policy set id as import ID. All the rules of the
+// policy set except the default rule are then managed by the list.
+func (l ruleList) importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := strings.TrimSpace(d.Id())
+	if importID == "" {
+		return nil, fmt.Errorf("invalid import ID, expected the policy set id")
+	}
+	resourceMap := separateResourceID(importID)
+	if !strings.Contains(importID, ":=") {
+		resourceMap = map[string]string{"policy_id": importID}
+	}
+	d.SetId(joinResourceID(resourceMap))
+	if err := diagsToError(l.read(ctx, d, m)); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("unable to import %q, policy set not found", importID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// entries returns the rules of the policy set in rank order, without the
+// default rule.
+func (l ruleList) entries(client *isegosdk.Client, policyID string) ([]ruleListEntry, *resty.Response, error) {
+	restyResp, err := l.list(client, policyID)
+	if err != nil {
+		return nil, restyResp, err
+	}
+	if restyResp == nil {
+		return nil, nil, fmt.Errorf("empty response")
+	}
+	response := struct {
+		Response []ruleListEntry `json:"response"`
+	}{}
+	if err := json.Unmarshal(restyResp.Body(), &response); err != nil {
+		return nil, restyResp, err
+	}
+	entries := []ruleListEntry{}
+	for _, entry := range response.Response {
+		if !entry.isDefault() {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].rank() < entries[j].rank()
+	})
+	return entries, restyResp, nil
+}
+
+// apply makes the rules of the policy set match the list in key: the rules
+// named in removed and no longer listed are deleted, then each listed rule is created,
+// or updated when its attributes or its rank differ. The ranks ISE shifts on
+// each call are tracked so rules already in place are left alone.
+func (l ruleList) apply(ctx context.Context, d *schema.ResourceData, m interface{}, policyID string, key string, removed []string) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+	var diags diag.Diagnostics
+
+	desired, err := l.expandRules(d, m, key)
+	if err != nil {
+		diags = append(diags, diagError(fmt.Sprintf("Failure when expanding %sList", l.operation), err))
+		return diags
+	}
+	entries, restyResp, err := l.entries(client, policyID)
+	if err != nil {
+		diags = append(diags, diagErrorWithOptionalResponse(fmt.Sprintf("Failure when executing Get%ss", l.operation), err, restyResp))
+		return diags
+	}
+	byName := map[string]ruleListEntry{}
+	order := []string{}
+	for _, entry := range entries {
+		byName[entry.name()] = entry
+		order = append(order, entry.id())
+	}
+	listed := map[string]bool{}
+	for _, rule := range desired {
+		listed[ruleListBodyName(rule)] = true
+	}
+
+	for _, name := range removed {
+		entry, ok := byName[name]
+		if !ok || listed[name] {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting %s %s", l.operation, name)
+		if restyResp, err := l.delete(client, policyID, entry.id()); err != nil {
+			diags = append(diags, diagErrorWithOptionalResponse(fmt.Sprintf("Failure when executing Delete%sByID", l.operation), err, restyResp))
+			return diags
+		}
+		order = removeString(order, entry.id())
+		delete(byName, name)
+	}
+
+	path := fmt.Sprintf(l.path, policyID)
+	for rank, body := range desired {
+		name := ruleListBodyName(body)
+		body["rule"].(map[string]interface{})["rank"] = rank
+		entry, exists := byName[name]
+		if !exists {
+			log.Printf("[DEBUG] Creating %s %s at rank %d", l.operation, name, rank)
+			result := map[string]interface{}{}
+			restyResp, err := executeConditionJSONRequest(clientConfig, "Create"+l.operation, resty.MethodPost, path, body, &result)
+			if err != nil {
+				diags = append(diags, diagErrorWithOptionalResponse("Failure when executing Create"+l.operation, err, restyResp))
+				return diags
+			}
+			id := interfaceToString(getJSONPath(result, []string{"response", "rule", "id"}))
+			order = insertString(order, rank, id)
+			continue
+		}
+		position := indexString(order, entry.id())
+		if position == rank && ruleListEntryMatches(entry, body) {
+			continue
+		}
+		log.Printf("[DEBUG] Updating %s %s at rank %d, previously %d", l.operation, name, rank, position)
+		body["rule"].(map[string]interface{})["id"] = entry.id()
+		restyResp, err := executeConditionJSONRequest(clientConfig, fmt.Sprintf("Update%sByID", l.operation), resty.MethodPut, path+"/"+entry.id(), body, &map[string]interface{}{})
+		if err != nil {
+			diags = append(diags, diagErrorWithOptionalResponse(fmt.Sprintf("Failure when executing Update%sByID", l.operation), err, restyResp))
+			return diags
+		}
+		order = insertString(removeString(order, entry.id()), rank, entry.id())
+	}
+
+	unmanaged := []string{}
+	for _, entry := range entries {
+		if !listed[entry.name()] && indexString(order, entry.id()) >= 0 {
+			unmanaged = append(unmanaged, entry.name())
+		}
+	}
+	if len(unmanaged) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Policy set %s has rules not managed by the list", policyID),
+			Detail:   fmt.Sprintf("The rules %s are not in the list. They are kept below the listed rules.", strings.Join(unmanaged, ", ")),
+		})
+	}
+	return diags
+}
+
+// expandRules returns the request bodies of the rules listed in rulesKey,
+// without ranks.
+func (l ruleList) expandRules(d *schema.ResourceData, m interface{}, rulesKey string) ([]map[string]interface{}, error) {
+	rules, _ := d.Get(rulesKey).([]interface{})
+	bodies := []map[string]interface{}{}
+	names := map[string]bool{}
+	for i := range rules {
+		key := fmt.Sprintf("%s.%d", rulesKey, i)
+		name := interfaceToString(d.Get(key + ".name"))
+		if names[name] {
+			return nil, fmt.Errorf("rule %s is listed more than once", name)
+		}
+		names[name] = true
+		rule := map[string]interface{}{"name": name}
+		if v, ok := d.GetOk(key + ".state"); ok {
+			rule["state"] = interfaceToString(v)
+		}
+		conditions := l.conditions
+		conditions.key = key + ".condition_json"
+		conditions.expressionKey = key + ".condition_expression"
+		conditions.nameKey = key + ".condition_name"
+		condition, err := conditions.condition(d)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %v", name, err)
+		}
+		if condition != nil {
+			if err := conditions.resolveReferences(m, condition, map[string]string{}); err != nil {
+				return nil, fmt.Errorf("rule %s: %v", name, err)
+			}
+			rule["condition"] = condition
+		}
+		body := map[string]interface{}{"rule": rule}
+		for _, result := range l.results {
+			v, ok := d.GetOk(key + "." + result.key)
+			if !ok {
+				continue
+			}
+			if result.schema.Type == schema.TypeList {
+				body[result.apiKey] = interfaceToSliceString(v)
+			} else {
+				body[result.apiKey] = interfaceToString(v)
+			}
+		}
+		bodies = append(bodies, body)
+	}
+	return bodies, nil
+}
+
+// flattenEntry returns the parameters of a rule read from ISE. The condition
+// is written in the attribute used by the prior rule, or as an expression
+// for imported rules.
+func (l ruleList) flattenEntry(entry ruleListEntry, prior map[string]interface{}) map[string]interface{} {
+	rule := entry.rule()
+	respItem := map[string]interface{}{
+		"name":  entry.name(),
+		"state": conditionString(rule, "state"),
+	}
+	flattenPriorCondition(respItem, normalizeConditionJSON(rule["condition"]), prior)
+	for _, result := range l.results {
+		if result.schema.Type == schema.TypeList {
+			respItem[result.key] = interfaceToSliceString(entry[result.apiKey])
+		} else {
+			respItem[result.key] = conditionString(entry, result.apiKey)
+		}
+	}
+	return respItem
+}
+
+// flattenPriorCondition sets the condition in respItem, in the attribute
+// used by prior, or as an expression when there is no prior value.
+func flattenPriorCondition(respItem map[string]interface{}, condition interface{}, prior map[string]interface{}) {
+	priorJSON := interfaceToString(prior["condition_json"])
+	priorExpression := interfaceToString(prior["condition_expression"])
+	priorName := interfaceToString(prior["condition_name"])
+	switch {
+	case prior != nil && priorJSON != "":
+		respItem["condition_json"] = flattenConditionJSON(condition, priorJSON)
+	case prior != nil && priorExpression != "":
+		respItem["condition_expression"] = flattenConditionExpression(condition, priorExpression)
+	case prior != nil && priorName != "":
+		respItem["condition_name"] = flattenConditionName(condition)
+	case prior == nil && condition != nil:
+		if expression, err := formatConditionExpression(condition); err == nil {
+			respItem["condition_expression"] = expression
+		} else {
+			respItem["condition_json"] = interfaceToJSONString(condition)
+		}
+	}
+}
+
+// ruleListEntryMatches reports whether the rule on ISE already has the
+// attributes of body, ignoring its rank.
+func ruleListEntryMatches(entry ruleListEntry, body map[string]interface{}) bool {
+	expected := map[string]interface{}{}
+	if b, err := json.Marshal(body); err == nil {
+		_ = json.Unmarshal(b, &expected)
+	}
+	if rule, ok := expected["rule"].(map[string]interface{}); ok {
+		delete(rule, "rank")
+	}
+	return conditionTreeContains(normalizeConditionJSON(map[string]interface{}(entry)), normalizeConditionJSON(expected))
+}
+
+func ruleListBodyName(body map[string]interface{}) string {
+	rule, _ := body["rule"].(map[string]interface{})
+	return conditionString(rule, "name")
+}
+
+func diagErrorWithOptionalResponse(summaryErr string, err error, restyResp *resty.Response) diag.Diagnostic {
+	if restyResp != nil {
+		return diagErrorWithResponse(summaryErr, err, restyResp.String())
+	}
+	return diagError(summaryErr, err)
+}
+
+func indexString(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeString(values []string, value string) []string {
+	result := []string{}
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func insertString(values []string, index int, value string) []string {
+	if index > len(values) {
+		index = len(values)
+	}
+	result := append([]string{}, values[:index]...)
+	result = append(result, value)
+	return append(result, values[index:]...)
+}
@@ -0,0 +1,206 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testRuleListServer is an ISE policy set whose rules shift ranks on create,
+// update and delete like ISE does.
+type testRuleListServer struct {
+	rules []map[string]interface{}
+	calls []string
+	next  int
+}
+
+func (s *testRuleListServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	prefix := "/api/v1/policy/network-access/policy-set/p1/authorization"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	if r.Method != http.MethodGet {
+		s.calls = append(s.calls, r.Method+" "+id)
+	}
+	body := map[string]interface{}{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	switch r.Method {
+	case http.MethodGet:
+		for i, entry := range s.rules {
+			entry["rule"].(map[string]interface{})["rank"] = i
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"response": s.rules})
+	case http.MethodPost:
+		s.next++
+		rule := body["rule"].(map[string]interface{})
+		rule["id"] = fmt.Sprintf("new%d", s.next)
+		s.insert(body)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"response": body})
+	case http.MethodPut:
+		s.remove(id)
+		s.insert(body)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"response": body})
+	case http.MethodDelete:
+		s.remove(id)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": id})
+	}
+}
+
+func (s *testRuleListServer) insert(entry map[string]interface{}) {
+	rank := int(entry["rule"].(map[string]interface{})["rank"].(float64))
+	s.rules = append(s.rules[:rank], append([]map[string]interface{}{entry}, s.rules[rank:]...)...)
+}
+
+func (s *testRuleListServer) remove(id string) {
+	rules := []map[string]interface{}{}
+	for _, entry := range s.rules {
+		if entry["rule"].(map[string]interface{})["id"] != id {
+			rules = append(rules, entry)
+		}
+	}
+	s.rules = rules
+}
+
+func (s *testRuleListServer) names() []string {
+	names := []string{}
+	for _, entry := range s.rules {
+		names = append(names, entry["rule"].(map[string]interface{})["name"].(string))
+	}
+	return names
+}
+
+func testRuleListEntry(id string, name string, profile string) map[string]interface{} {
+	return map[string]interface{}{
+		"rule": map[string]interface{}{
+			"id":        id,
+			"name":      name,
+			"state":     "enabled",
+			"condition": map[string]interface{}{"conditionType": "ConditionReference", "id": testRuleListConditionID(name), "name": "Condition_" + name},
+		},
+		"profile": []interface{}{profile},
+	}
+}
+
+func testRuleListRule(name string, profile string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                 name,
+		"condition_expression": fmt.Sprintf(`ref("%s")`, testRuleListConditionID(name)),
+		"profile":              []interface{}{profile},
+	}
+}
+
+// testRuleListConditionID returns the id of the library condition of a rule,
+// referenced by id so no library lookup is needed.
+func testRuleListConditionID(name string) string {
+	return fmt.Sprintf("00000000-0000-0000-0000-%012x", name[0])
+}
+
+func TestRuleListApply(t *testing.T) {
+	server := &testRuleListServer{rules: []map[string]interface{}{
+		testRuleListEntry("a", "A", "PermitAccess"),
+		testRuleListEntry("x", "X", "PermitAccess"),
+		testRuleListEntry("b", "B", "PermitAccess"),
+	}}
+	defaultRule := testRuleListEntry("d", "Default", "DenyAccess")
+	defaultRule["rule"].(map[string]interface{})["default"] = true
+	server.rules = append(server.rules, defaultRule)
+	m := newTestClientConfig(t, server.ServeHTTP)
+
+	ruleList := networkAccessAuthorizationRuleList()
+	resource := resourceNetworkAccessAuthorizationRuleList()
+	apply := func(rules []interface{}, removed []string) diag.Diagnostics {
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"parameters": []interface{}{
+				map[string]interface{}{"policy_id": "p1", "rules": rules},
+			},
+		})
+		return ruleList.apply(context.Background(), d, m, "p1", "parameters.0.rules", removed)
+	}
+
+	rules := []interface{}{
+		testRuleListRule("B", "PermitAccess"),
+		testRuleListRule("A", "PermitAccess"),
+		testRuleListRule("C", "PermitAccess"),
+	}
+	diags := apply(rules, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if expected := []string{"PUT b", "POST "}; !reflect.DeepEqual(server.calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, server.calls)
+	}
+	if expected := []string{"B", "A", "C", "X", "Default"}; !reflect.DeepEqual(server.names(), expected) {
+		t.Errorf("expected rules %v, got %v", expected, server.names())
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "X") {
+		t.Errorf("expected a warning about the unmanaged rule X, got %v", diags)
+	}
+
+	server.calls = nil
+	diags = apply(rules[:2], []string{"B", "A", "C"})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if expected := []string{"DELETE new1"}; !reflect.DeepEqual(server.calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, server.calls)
+	}
+
+	server.calls = nil
+	rules[1].(map[string]interface{})["profile"] = []interface{}{"DenyAccess"}
+	diags = apply(rules[:2], nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if expected := []string{"PUT a"}; !reflect.DeepEqual(server.calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, server.calls)
+	}
+}
+
+func TestRuleListEntryMatches(t *testing.T) {
+	entry := ruleListEntry{}
+	_ = json.Unmarshal([]byte(`{"rule":{"id":"a","name":"A","rank":3,"state":"enabled","default":false,"hitCounts":12,
+	  "condition":{"conditionType":"ConditionReference","id":"c1","name":"Wired_802.1X","isNegate":false}},
+	  "profile":["PermitAccess"],"securityGroup":"","link":{"href":"https://ise/a"}}`), &entry)
+	cases := map[string]struct {
+		Body        string
+		ExpectMatch bool
+	}{
+		"same attributes": {
+			Body:        `{"rule":{"name":"A","rank":0,"state":"enabled","condition":{"conditionType":"ConditionReference","id":"c1"}},"profile":["PermitAccess"]}`,
+			ExpectMatch: true,
+		},
+		"without state": {
+			Body:        `{"rule":{"name":"A","rank":0},"profile":["PermitAccess"]}`,
+			ExpectMatch: true,
+		},
+		"other profile": {
+			Body:        `{"rule":{"name":"A","rank":0},"profile":["PermitAccess","Guest"]}`,
+			ExpectMatch: false,
+		},
+		"negated condition": {
+			Body:        `{"rule":{"name":"A","rank":0,"condition":{"conditionType":"ConditionReference","id":"c1","isNegate":true}}}`,
+			ExpectMatch: false,
+		},
+		"disabled": {
+			Body:        `{"rule":{"name":"A","rank":0,"state":"disabled"}}`,
+			ExpectMatch: false,
+		},
+	}
+	for tn, tc := range cases {
+		body := map[string]interface{}{}
+		_ = json.Unmarshal([]byte(tc.Body), &body)
+		if ruleListEntryMatches(entry, body) != tc.ExpectMatch {
+			t.Errorf("bad: %s, expected ruleListEntryMatches to return %t", tn, tc.ExpectMatch)
+		}
+	}
+}
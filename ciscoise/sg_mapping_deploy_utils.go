@@ -0,0 +1,447 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+)
+
+// sgMappingDeployResultValue is a name and value pair of the deploy status.
+type sgMappingDeployResultValue struct {
+	name  string
+	value string
+}
+
+// sgMappingDeployStatusFunc reads the output of the last deploy, of IP to
+// SGT mappings or of mapping groups.
+type sgMappingDeployStatusFunc func() ([]sgMappingDeployResultValue, *resty.Response, error)
+
+// sgMappingDeployFailure is a network device the mappings were not deployed
+// to.
+type sgMappingDeployFailure struct {
+	device  string
+	message string
+}
+
+// sgMappingDeployResult is the outcome of a deploy, read from its status.
+type sgMappingDeployResult struct {
+	status      string
+	succeeded   []string
+	failed      []sgMappingDeployFailure
+	messages    []string
+	resultValue []sgMappingDeployResultValue
+}
+
+// Keywords of the deploy status values, matched case insensitively. A deploy
+// rejected because another one is running is a failure of the deploy, not a
+// deploy in progress.
+var (
+	sgMappingDeployPendingKeywords  = []string{"progress", "running", "pending"}
+	sgMappingDeployFailureKeywords  = []string{"fail", "error", "unreachable", "timeout", "timed out", "reject"}
+	sgMappingDeployRejectedKeywords = []string{"another deploy", "already running", "already in progress"}
+)
+
+// sgMappingDeploySummaryNames are the names, in lower case, of the entries of
+// the deploy status describing the whole deploy. The other entries are named
+// after a network device.
+var sgMappingDeploySummaryNames = map[string]bool{
+	"deploy status":           true,
+	"deploy result":           true,
+	"status":                  true,
+	"message":                 true,
+	"total devices":           true,
+	"total number of devices": true,
+	"number of devices":       true,
+	"success count":           true,
+	"failure count":           true,
+	"start time":              true,
+	"end time":                true,
+	"deploy start time":       true,
+	"deploy end time":         true,
+	"last deploy time":        true,
+}
+
+// sgMappingDeployTimeNames are the names, in lower case, of the entries of
+// the deploy status holding the time of the deploy, which change on each
+// deploy.
+var sgMappingDeployTimeNames = map[string]bool{
+	"start time":        true,
+	"end time":          true,
+	"deploy start time": true,
+	"deploy end time":   true,
+	"last deploy time":  true,
+}
+
+func containsAnyFold(s string, keywords []string) bool {
+	s = strings.ToLower(s)
+	for _, keyword := range keywords {
+		if strings.Contains(s, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSgMappingDeploySummaryName(name string) bool {
+	return sgMappingDeploySummaryNames[strings.ToLower(strings.TrimSpace(name))]
+}
+
+// sgMappingDeployTimes returns the time entries of the deploy status, by
+// name in lower case.
+func sgMappingDeployTimes(values []sgMappingDeployResultValue) map[string]string {
+	times := map[string]string{}
+	for _, item := range values {
+		name := strings.ToLower(strings.TrimSpace(item.name))
+		if sgMappingDeployTimeNames[name] {
+			times[name] = item.value
+		}
+	}
+	return times
+}
+
+// sgMappingDeployChanged reports whether values and before are the status of
+// different deploys, and whether that could be told. The times of the deploy
+// tell deploys apart when both statuses have them. Otherwise the statuses are
+// compared, and equal ones may still be of different deploys.
+func sgMappingDeployChanged(before []sgMappingDeployResultValue, values []sgMappingDeployResultValue) (changed bool, certain bool) {
+	beforeTimes := sgMappingDeployTimes(before)
+	times := sgMappingDeployTimes(values)
+	if len(beforeTimes) > 0 && len(times) > 0 {
+		return !reflect.DeepEqual(beforeTimes, times), true
+	}
+	if before == nil {
+		return false, false
+	}
+	changed = !reflect.DeepEqual(values, before)
+	return changed, changed
+}
+
+// parseSgMappingDeployStatus returns the result of the deploy status, done
+// false while the deploy is still running. An empty status means the deploy
+// has not reported yet.
+func parseSgMappingDeployStatus(values []sgMappingDeployResultValue) (*sgMappingDeployResult, bool) {
+	result := &sgMappingDeployResult{
+		status:      "SUCCESS",
+		succeeded:   []string{},
+		failed:      []sgMappingDeployFailure{},
+		messages:    []string{},
+		resultValue: values,
+	}
+	if len(values) == 0 {
+		result.status = "IN_PROGRESS"
+		return result, false
+	}
+	done := true
+	for _, item := range values {
+		switch {
+		case containsAnyFold(item.value, sgMappingDeployRejectedKeywords):
+			result.messages = append(result.messages, fmt.Sprintf("%s: %s", item.name, item.value))
+		case isSgMappingDeploySummaryName(item.name):
+			if containsAnyFold(item.value, sgMappingDeployFailureKeywords) {
+				result.messages = append(result.messages, fmt.Sprintf("%s: %s", item.name, item.value))
+			} else if containsAnyFold(item.value, sgMappingDeployPendingKeywords) {
+				done = false
+			}
+		case containsAnyFold(item.value, sgMappingDeployFailureKeywords):
+			result.failed = append(result.failed, sgMappingDeployFailure{device: item.name, message: item.value})
+		case containsAnyFold(item.value, sgMappingDeployPendingKeywords):
+			done = false
+		default:
+			result.succeeded = append(result.succeeded, item.name)
+		}
+	}
+	switch {
+	case !done:
+		result.status = "IN_PROGRESS"
+	case len(result.failed) > 0 || len(result.messages) > 0:
+		result.status = "FAILED"
+	}
+	return result, done
+}
+
+// waitSgMappingDeploy polls the deploy status, right away and then every
+// interval, until the deploy is done or timeout expires. The status only
+// holds the last deploy, so a done status is only taken once the deploy was
+// seen in progress or the status differs from before, the status read before
+// the deploy, nil when it could not be read. A redeploy may finish before the
+// first poll with the same status, so when the deploys can not be told apart
+// an unchanged SUCCESS status is taken after grace.
+func waitSgMappingDeploy(ctx context.Context, timeout time.Duration, interval time.Duration, grace time.Duration, before []sgMappingDeployResultValue, status sgMappingDeployStatusFunc) (*sgMappingDeployResult, error) {
+	start := time.Now()
+	deadline := start.Add(timeout)
+	var result *sgMappingDeployResult
+	started := false
+	for wait := time.Duration(0); ; wait = interval {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-time.After(wait):
+		}
+		values, restyResp, err := status()
+		if err != nil {
+			if restyResp != nil {
+				log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp.String()))
+			}
+			log.Printf("[DEBUG] Deploy status error: %s", err.Error())
+		} else {
+			var done bool
+			result, done = parseSgMappingDeployStatus(values)
+			changed, certain := sgMappingDeployChanged(before, values)
+			started = started || !done || changed
+			log.Printf("[DEBUG] Deploy status %s", result.status)
+			switch {
+			case done && started:
+				return result, nil
+			case done && !certain && result.status == "SUCCESS" && time.Since(start) >= grace:
+				log.Printf("[DEBUG] Deploy status unchanged after %s, taking it as the result of the deploy", grace)
+				return result, nil
+			case done:
+				log.Printf("[DEBUG] Deploy status unchanged since before the deploy, waiting for the deploy to report")
+			}
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return result, fmt.Errorf("timeout waiting for the deploy: %s", err.Error())
+			}
+			if !started {
+				return result, fmt.Errorf("timeout waiting for the deploy, the status still shows the previous deploy")
+			}
+			return result, fmt.Errorf("timeout waiting for the deploy, last status %s", result.status)
+		}
+	}
+}
+
+// sgMappingDeployCreate runs a deploy, waits for it and stores its result in
+// item. A deploy that failed on some network devices keeps the resource,
+// tainted, so the next apply deploys again.
+func sgMappingDeployCreate(ctx context.Context, d *schema.ResourceData, operation string, deploy func() (*resty.Response, error), status sgMappingDeployStatusFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[DEBUG] Selected method: %s", operation)
+	before, restyResp0, err := status()
+	if err != nil {
+		if restyResp0 != nil {
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(restyResp0.String()))
+		}
+		log.Printf("[WARN] Unable to read the deploy status before %s, waiting for the deploy to be in progress: %s", operation, err.Error())
+		before = nil
+	}
+	response1, err := deploy()
+	if err != nil || response1 == nil {
+		if response1 != nil {
+			log.Printf("[DEBUG] Retrieved error response %s", redactSecrets(response1.String()))
+			diags = append(diags, diagErrorWithAltAndResponse(
+				"Failure when executing "+operation, err, response1.String(),
+				"Failure at "+operation+", unexpected response", ""))
+			return diags
+		}
+		diags = append(diags, diagErrorWithAlt(
+			"Failure when executing "+operation, err,
+			"Failure at "+operation+", unexpected response", ""))
+		return diags
+	}
+	log.Printf("[DEBUG] Retrieved response %s", redactSecrets(response1.String()))
+
+	result, err := waitSgMappingDeploy(ctx, d.Timeout(schema.TimeoutCreate), SG_MAPPING_DEPLOY_STATUS_SLEEP, SG_MAPPING_DEPLOY_GRACE_PERIOD, before, status)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for "+operation, err))
+		return diags
+	}
+	if err := d.Set("item", flattenSgMappingDeployResult(result)); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting "+operation+" response",
+			err))
+		return diags
+	}
+	_ = d.Set("last_updated", getUnixTimeString())
+	d.SetId(getUnixTimeString())
+	return append(diags, sgMappingDeployDiags(operation, result)...)
+}
+
+// sgMappingDeployDiags returns an error per network device the deploy
+// failed on, and per failure reported for the whole deploy.
+func sgMappingDeployDiags(operation string, result *sgMappingDeployResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, failure := range result.failed {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s failed on network device %s", operation, failure.device),
+			Detail:   failure.message,
+		})
+	}
+	for _, message := range result.messages {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  operation + " failed",
+			Detail:   message,
+		})
+	}
+	return diags
+}
+
+func flattenSgMappingDeployResult(result *sgMappingDeployResult) []map[string]interface{} {
+	respItem := make(map[string]interface{})
+	respItem["status"] = result.status
+	respItem["devices_succeeded"] = result.succeeded
+	devicesFailed := []map[string]interface{}{}
+	for _, failure := range result.failed {
+		devicesFailed = append(devicesFailed, map[string]interface{}{
+			"device":  failure.device,
+			"message": failure.message,
+		})
+	}
+	respItem["devices_failed"] = devicesFailed
+	resultValue := []map[string]interface{}{}
+	for _, item := range result.resultValue {
+		resultValue = append(resultValue, map[string]interface{}{
+			"name":  item.name,
+			"value": item.value,
+		})
+	}
+	respItem["result_value"] = resultValue
+	return []map[string]interface{}{
+		respItem,
+	}
+}
+
+// sgMappingDeployItemSchema is the item of the deploy resources, the result
+// of the deploy.
+func sgMappingDeployItemSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `Result of the deploy`,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"devices_failed": &schema.Schema{
+					Description: `Network devices the deploy failed on`,
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"device": &schema.Schema{
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"message": &schema.Schema{
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"devices_succeeded": &schema.Schema{
+					Description: `Network devices the mappings were deployed to`,
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"result_value": &schema.Schema{
+					Description: `Deploy status as returned by ISE`,
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": &schema.Schema{
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"value": &schema.Schema{
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"status": &schema.Schema{
+					Description: `SUCCESS or FAILED`,
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// resourceSgMappingDeployResourceV0 is the schema used by the deploy
+// resources before item held the result of the deploy.
+func resourceSgMappingDeployResourceV0(parameters map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"item": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				MinItems: 1,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: parameters,
+				},
+			},
+		},
+	}
+}
+
+// resourceSgMappingDeployStateUpgraders drop the raw response string
+// previously stored in item.
+func resourceSgMappingDeployStateUpgraders(parameters map[string]*schema.Schema) []schema.StateUpgrader {
+	return []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourceSgMappingDeployResourceV0(parameters).CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceBulkRequestStateUpgradeV0,
+		},
+	}
+}
+
+func sgMappingDeployStatus(client *isegosdk.Client) sgMappingDeployStatusFunc {
+	return func() ([]sgMappingDeployResultValue, *resty.Response, error) {
+		response, restyResp, err := client.IPToSgtMapping.GetDeployStatusIPToSgtMapping()
+		if err != nil || response == nil {
+			return nil, restyResp, err
+		}
+		values := []sgMappingDeployResultValue{}
+		if response.OperationResult != nil && response.OperationResult.ResultValue != nil {
+			for _, item := range *response.OperationResult.ResultValue {
+				values = append(values, sgMappingDeployResultValue{name: item.Name, value: item.Value})
+			}
+		}
+		return values, restyResp, nil
+	}
+}
+
+func sgMappingGroupDeployStatus(client *isegosdk.Client) sgMappingDeployStatusFunc {
+	return func() ([]sgMappingDeployResultValue, *resty.Response, error) {
+		response, restyResp, err := client.IPToSgtMappingGroup.GetDeployStatusIPToSgtMappingGroup()
+		if err != nil || response == nil {
+			return nil, restyResp, err
+		}
+		values := []sgMappingDeployResultValue{}
+		if response.OperationResult != nil && response.OperationResult.ResultValue != nil {
+			for _, item := range *response.OperationResult.ResultValue {
+				values = append(values, sgMappingDeployResultValue{name: item.Name, value: item.Value})
+			}
+		}
+		return values, restyResp, nil
+	}
+}
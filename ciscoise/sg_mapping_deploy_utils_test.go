@@ -0,0 +1,246 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestParseSgMappingDeployStatus(t *testing.T) {
+	cases := map[string]struct {
+		Values    []sgMappingDeployResultValue
+		Done      bool
+		Status    string
+		Succeeded []string
+		Failed    []sgMappingDeployFailure
+	}{
+		"not reported yet": {
+			Done:   false,
+			Status: "IN_PROGRESS",
+		},
+		"in progress": {
+			Values: []sgMappingDeployResultValue{{name: "Deploy Status", value: "Deploy in progress"}},
+			Done:   false,
+			Status: "IN_PROGRESS",
+		},
+		"all devices succeeded": {
+			Values: []sgMappingDeployResultValue{
+				{name: "Deploy Status", value: "Completed"},
+				{name: "switch-01", value: "SUCCESS"},
+				{name: "10.0.0.2", value: "Deployed 12 mappings"},
+			},
+			Done:      true,
+			Status:    "SUCCESS",
+			Succeeded: []string{"switch-01", "10.0.0.2"},
+		},
+		"device failed": {
+			Values: []sgMappingDeployResultValue{
+				{name: "Total Devices", value: "2"},
+				{name: "switch-01", value: "SUCCESS"},
+				{name: "switch-02", value: "FAILED: device unreachable"},
+			},
+			Done:      true,
+			Status:    "FAILED",
+			Succeeded: []string{"switch-01"},
+			Failed:    []sgMappingDeployFailure{{device: "switch-02", message: "FAILED: device unreachable"}},
+		},
+		"another deploy running": {
+			Values: []sgMappingDeployResultValue{{name: "Deploy Status", value: "Deploy failed, another deploy is running"}},
+			Done:   true,
+			Status: "FAILED",
+		},
+		"another deploy in progress": {
+			Values: []sgMappingDeployResultValue{{name: "Message", value: "Another deploy is in progress"}},
+			Done:   true,
+			Status: "FAILED",
+		},
+		"device named like a summary": {
+			Values: []sgMappingDeployResultValue{
+				{name: "Deploy Status", value: "Completed"},
+				{name: "account-sw1", value: "SUCCESS"},
+				{name: "time-clock-sw", value: "SUCCESS"},
+			},
+			Done:      true,
+			Status:    "SUCCESS",
+			Succeeded: []string{"account-sw1", "time-clock-sw"},
+		},
+		"summary failure": {
+			Values: []sgMappingDeployResultValue{{name: "Deploy Status", value: "Failed"}},
+			Done:   true,
+			Status: "FAILED",
+		},
+	}
+	for tn, tc := range cases {
+		result, done := parseSgMappingDeployStatus(tc.Values)
+		if done != tc.Done || result.status != tc.Status {
+			t.Errorf("bad: %s, expected done %t status %s, got done %t status %s", tn, tc.Done, tc.Status, done, result.status)
+		}
+		if tc.Succeeded == nil {
+			tc.Succeeded = []string{}
+		}
+		if tc.Failed == nil {
+			tc.Failed = []sgMappingDeployFailure{}
+		}
+		if done && (!reflect.DeepEqual(result.succeeded, tc.Succeeded) || !reflect.DeepEqual(result.failed, tc.Failed)) {
+			t.Errorf("bad: %s, expected succeeded %v failed %v, got succeeded %v failed %v", tn, tc.Succeeded, tc.Failed, result.succeeded, result.failed)
+		}
+	}
+}
+
+func TestWaitSgMappingDeploy(t *testing.T) {
+	responses := [][]sgMappingDeployResultValue{
+		nil,
+		{{name: "Deploy Status", value: "In Progress"}},
+		{{name: "switch-01", value: "SUCCESS"}, {name: "switch-02", value: "Error: timed out"}},
+	}
+	calls := 0
+	status := func() ([]sgMappingDeployResultValue, *resty.Response, error) {
+		calls++
+		if calls == 1 {
+			return nil, nil, fmt.Errorf("connection reset")
+		}
+		return responses[calls-2], nil, nil
+	}
+	result, err := waitSgMappingDeploy(context.Background(), time.Minute, time.Millisecond, time.Minute, []sgMappingDeployResultValue{}, status)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if calls != 4 || result.status != "FAILED" {
+		t.Errorf("expected FAILED after 4 calls, got %s after %d calls", result.status, calls)
+	}
+	diags := sgMappingDeployDiags("DeployAllIPToSgtMapping", result)
+	if len(diags) != 1 || diags[0].Summary != "DeployAllIPToSgtMapping failed on network device switch-02" {
+		t.Errorf("expected one diagnostic for switch-02, got %v", diags)
+	}
+	item := flattenSgMappingDeployResult(result)
+	if !reflect.DeepEqual(item[0]["devices_succeeded"], []string{"switch-01"}) {
+		t.Errorf("expected devices_succeeded [switch-01], got %v", item[0]["devices_succeeded"])
+	}
+
+	pending := func() ([]sgMappingDeployResultValue, *resty.Response, error) {
+		return responses[1], nil, nil
+	}
+	if _, err := waitSgMappingDeploy(context.Background(), 5*time.Millisecond, time.Millisecond, time.Minute, []sgMappingDeployResultValue{}, pending); err == nil {
+		t.Errorf("expected a timeout error")
+	}
+}
+
+func TestWaitSgMappingDeployPreviousStatus(t *testing.T) {
+	previous := []sgMappingDeployResultValue{{name: "Deploy Status", value: "Completed"}, {name: "switch-01", value: "SUCCESS"}}
+	failed := []sgMappingDeployResultValue{{name: "Deploy Status", value: "Completed"}, {name: "switch-01", value: "FAILED: device unreachable"}}
+	inProgress := []sgMappingDeployResultValue{{name: "Deploy Status", value: "In Progress"}}
+	previousTimed := []sgMappingDeployResultValue{{name: "Start Time", value: "2024-05-02 10:00:00"}, {name: "switch-01", value: "SUCCESS"}}
+	redeployTimed := []sgMappingDeployResultValue{{name: "Start Time", value: "2024-05-02 10:05:00"}, {name: "switch-01", value: "SUCCESS"}}
+	cases := map[string]struct {
+		Before      []sgMappingDeployResultValue
+		Responses   [][]sgMappingDeployResultValue
+		Grace       time.Duration
+		Calls       int
+		Status      string
+		ExpectError bool
+	}{
+		"previous deploy then changed": {
+			Before:    previous,
+			Responses: [][]sgMappingDeployResultValue{previous, previous, failed},
+			Grace:     time.Minute,
+			Calls:     3,
+			Status:    "FAILED",
+		},
+		"same result after in progress": {
+			Before:    previous,
+			Responses: [][]sgMappingDeployResultValue{previous, inProgress, previous},
+			Grace:     time.Minute,
+			Calls:     3,
+			Status:    "SUCCESS",
+		},
+		"unknown before requires in progress": {
+			Responses: [][]sgMappingDeployResultValue{failed, inProgress, previous},
+			Grace:     time.Minute,
+			Calls:     3,
+			Status:    "SUCCESS",
+		},
+		"previous deploy only": {
+			Before:      previous,
+			Responses:   [][]sgMappingDeployResultValue{previous},
+			Grace:       time.Minute,
+			ExpectError: true,
+		},
+		"redeploy with the same result": {
+			Before:    previous,
+			Responses: [][]sgMappingDeployResultValue{previous},
+			Calls:     1,
+			Status:    "SUCCESS",
+		},
+		"previous failure only": {
+			Before:      failed,
+			Responses:   [][]sgMappingDeployResultValue{failed},
+			ExpectError: true,
+		},
+		"redeploy with a new start time": {
+			Before:    previousTimed,
+			Responses: [][]sgMappingDeployResultValue{redeployTimed},
+			Grace:     time.Minute,
+			Calls:     1,
+			Status:    "SUCCESS",
+		},
+		"previous start time only": {
+			Before:      previousTimed,
+			Responses:   [][]sgMappingDeployResultValue{previousTimed},
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		calls := 0
+		status := func() ([]sgMappingDeployResultValue, *resty.Response, error) {
+			calls++
+			if calls > len(tc.Responses) {
+				return tc.Responses[len(tc.Responses)-1], nil, nil
+			}
+			return tc.Responses[calls-1], nil, nil
+		}
+		result, err := waitSgMappingDeploy(context.Background(), 20*time.Millisecond, time.Millisecond, tc.Grace, tc.Before, status)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expected error %t, got %v", tn, tc.ExpectError, err)
+			continue
+		}
+		if !tc.ExpectError && (calls != tc.Calls || result.status != tc.Status) {
+			t.Errorf("bad: %s, expected %s after %d calls, got %s after %d calls", tn, tc.Status, tc.Calls, result.status, calls)
+		}
+	}
+}
+
+func TestWaitSgMappingDeployRedeploySameStatus(t *testing.T) {
+	previous := []sgMappingDeployResultValue{{name: "Deploy Status", value: "Completed"}, {name: "switch-01", value: "SUCCESS"}}
+	calls := 0
+	status := func() ([]sgMappingDeployResultValue, *resty.Response, error) {
+		calls++
+		return previous, nil, nil
+	}
+	result, err := waitSgMappingDeploy(context.Background(), time.Minute, time.Millisecond, 10*time.Millisecond, previous, status)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if calls < 2 || result.status != "SUCCESS" {
+		t.Errorf("expected SUCCESS after the grace period, got %s after %d calls", result.status, calls)
+	}
+}
+
+func TestWaitSgMappingDeployPollsRightAway(t *testing.T) {
+	values := []sgMappingDeployResultValue{{name: "switch-01", value: "SUCCESS"}}
+	status := func() ([]sgMappingDeployResultValue, *resty.Response, error) {
+		return values, nil, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	result, err := waitSgMappingDeploy(ctx, time.Minute, time.Hour, time.Minute, []sgMappingDeployResultValue{}, status)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if result.status != "SUCCESS" {
+		t.Errorf("expected SUCCESS, got %s", result.status)
+	}
+}
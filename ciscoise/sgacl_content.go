@@ -0,0 +1,268 @@
+package ciscoise
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// sgaclACE is an access control entry of an SGACL, or a remark line. SGACL
+// entries have no addresses, the same content applies to IPv4 and IPv6.
+//
+//	ace      := action protocol [port "src"] [port "dst"] [icmp] ["established"] ["log"]
+//	         |  "remark" text
+//	action   := "permit" | "deny"
+//	protocol := "ip" | "tcp" | "udp" | "icmp" | ... | 0-255
+//	port     := ("src" | "dst") ("eq" | "neq" | "gt" | "lt") port | ("src" | "dst") "range" port port
+//	icmp     := type [code]
+//
+// Ports are only accepted with tcp and udp, icmp types with icmp, and
+// established with tcp.
+type sgaclACE struct {
+	remark          string
+	action          string
+	protocol        string
+	sourcePort      *sgaclPortMatch
+	destinationPort *sgaclPortMatch
+	icmpType        string
+	icmpCode        string
+	established     bool
+	log             bool
+}
+
+// sgaclPortMatch is a src or dst port condition of an ACE.
+type sgaclPortMatch struct {
+	operator string
+	ports    []string
+}
+
+var sgaclProtocols = map[string]bool{
+	"ahp": true, "eigrp": true, "esp": true, "gre": true, "icmp": true, "igmp": true, "ip": true,
+	"ipinip": true, "nos": true, "ospf": true, "pcp": true, "pim": true, "tcp": true, "udp": true,
+}
+
+var sgaclPortNames = map[string]bool{
+	"bgp": true, "bootpc": true, "bootps": true, "domain": true, "echo": true, "ftp": true,
+	"ftp-data": true, "isakmp": true, "kerberos": true, "ntp": true, "pop3": true, "smtp": true,
+	"snmp": true, "snmptrap": true, "ssh": true, "syslog": true, "tacacs": true, "telnet": true,
+	"tftp": true, "www": true,
+}
+
+var sgaclICMPTypes = map[string]bool{
+	"echo": true, "echo-reply": true, "packet-too-big": true, "parameter-problem": true,
+	"redirect": true, "source-quench": true, "time-exceeded": true, "traceroute": true,
+	"unreachable": true,
+}
+
+// parseSGACLContent parses the ACEs of an SGACL, one per line. Keywords are
+// case insensitive, and blank lines and extra whitespace are ignored.
+func parseSGACLContent(content string) ([]sgaclACE, error) {
+	aces := []sgaclACE{}
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		tokens := strings.Fields(line)
+		if len(tokens) == 0 {
+			continue
+		}
+		ace, err := parseSGACLACE(tokens)
+		if err != nil {
+			return nil, fmt.Errorf("line %d %q: %v", i+1, strings.TrimSpace(line), err)
+		}
+		aces = append(aces, ace)
+	}
+	return aces, nil
+}
+
+func parseSGACLACE(tokens []string) (sgaclACE, error) {
+	ace := sgaclACE{}
+	keyword := strings.ToLower(tokens[0])
+	if keyword == "remark" {
+		ace.remark = strings.Join(tokens[1:], " ")
+		return ace, nil
+	}
+	if keyword != "permit" && keyword != "deny" {
+		return ace, fmt.Errorf("expected permit, deny or remark, got %q", tokens[0])
+	}
+	ace.action = keyword
+	if len(tokens) < 2 {
+		return ace, fmt.Errorf("missing protocol after %s", keyword)
+	}
+	ace.protocol = strings.ToLower(tokens[1])
+	if !sgaclProtocols[ace.protocol] && !isNumberInRange(ace.protocol, 0, 255) {
+		return ace, fmt.Errorf("unknown protocol %q", tokens[1])
+	}
+	hasPorts := ace.protocol == "tcp" || ace.protocol == "udp"
+
+	rest := tokens[2:]
+	next := func() string {
+		if len(rest) == 0 {
+			return ""
+		}
+		return strings.ToLower(rest[0])
+	}
+	for _, direction := range []string{"src", "dst"} {
+		if next() != direction {
+			continue
+		}
+		if !hasPorts {
+			return ace, fmt.Errorf("%s ports are only allowed with tcp and udp", direction)
+		}
+		match, n, err := parseSGACLPortMatch(direction, rest[1:])
+		if err != nil {
+			return ace, err
+		}
+		rest = rest[1+n:]
+		if direction == "src" {
+			ace.sourcePort = match
+		} else {
+			ace.destinationPort = match
+		}
+	}
+	if ace.protocol == "icmp" && next() != "" && next() != "log" {
+		ace.icmpType = next()
+		if !sgaclICMPTypes[ace.icmpType] && !isNumberInRange(ace.icmpType, 0, 255) {
+			return ace, fmt.Errorf("unknown icmp type %q", rest[0])
+		}
+		rest = rest[1:]
+		if isNumberInRange(ace.icmpType, 0, 255) && isNumberInRange(next(), 0, 255) {
+			ace.icmpCode = next()
+			rest = rest[1:]
+		}
+	}
+	if next() == "established" {
+		if ace.protocol != "tcp" {
+			return ace, fmt.Errorf("established is only allowed with tcp")
+		}
+		ace.established = true
+		rest = rest[1:]
+	}
+	if next() == "log" {
+		ace.log = true
+		rest = rest[1:]
+	}
+	if len(rest) > 0 {
+		return ace, fmt.Errorf("unexpected %q", strings.Join(rest, " "))
+	}
+	return ace, nil
+}
+
+// parseSGACLPortMatch parses the operator and ports following src or dst,
+// returning the number of tokens used.
+func parseSGACLPortMatch(direction string, tokens []string) (*sgaclPortMatch, int, error) {
+	if len(tokens) == 0 {
+		return nil, 0, fmt.Errorf("missing port operator after %s", direction)
+	}
+	match := &sgaclPortMatch{operator: strings.ToLower(tokens[0])}
+	count := 1
+	switch match.operator {
+	case "eq", "neq", "gt", "lt":
+	case "range":
+		count = 2
+	default:
+		return nil, 0, fmt.Errorf("expected eq, neq, gt, lt or range after %s, got %q", direction, tokens[0])
+	}
+	if len(tokens) < 1+count {
+		return nil, 0, fmt.Errorf("missing port after %s %s", direction, match.operator)
+	}
+	for _, token := range tokens[1 : 1+count] {
+		port := strings.ToLower(token)
+		if !sgaclPortNames[port] && !isNumberInRange(port, 0, 65535) {
+			return nil, 0, fmt.Errorf("invalid port %q after %s %s", token, direction, match.operator)
+		}
+		match.ports = append(match.ports, port)
+	}
+	if match.operator == "range" && isNumberInRange(match.ports[0], 0, 65535) && isNumberInRange(match.ports[1], 0, 65535) {
+		low, _ := strconv.Atoi(match.ports[0])
+		high, _ := strconv.Atoi(match.ports[1])
+		if low > high {
+			return nil, 0, fmt.Errorf("invalid range %d %d after %s, the first port is greater than the last", low, high, direction)
+		}
+	}
+	return match, 1 + count, nil
+}
+
+func isNumberInRange(value string, min int, max int) bool {
+	number, err := strconv.Atoi(value)
+	return err == nil && strconv.Itoa(number) == value && number >= min && number <= max
+}
+
+func (a sgaclACE) String() string {
+	if a.action == "" {
+		return strings.TrimSpace("remark " + a.remark)
+	}
+	tokens := []string{a.action, a.protocol}
+	for direction, match := range []*sgaclPortMatch{a.sourcePort, a.destinationPort} {
+		if match != nil {
+			tokens = append(tokens, []string{"src", "dst"}[direction], match.operator)
+			tokens = append(tokens, match.ports...)
+		}
+	}
+	if a.icmpType != "" {
+		tokens = append(tokens, a.icmpType)
+	}
+	if a.icmpCode != "" {
+		tokens = append(tokens, a.icmpCode)
+	}
+	if a.established {
+		tokens = append(tokens, "established")
+	}
+	if a.log {
+		tokens = append(tokens, "log")
+	}
+	return strings.Join(tokens, " ")
+}
+
+// formatSGACLContent returns the ACEs one per line, in lower case with single
+// spaces.
+func formatSGACLContent(aces []sgaclACE) string {
+	lines := []string{}
+	for _, ace := range aces {
+		lines = append(lines, ace.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// normalizeSGACLContent returns the content with its whitespace and case
+// normalized, or the content itself when it does not parse.
+func normalizeSGACLContent(content string) string {
+	aces, err := parseSGACLContent(content)
+	if err != nil {
+		return content
+	}
+	return formatSGACLContent(aces)
+}
+
+// flattenSGACLContent returns the ACEs of content for the aces attribute,
+// none when it does not parse.
+func flattenSGACLContent(content string) []map[string]interface{} {
+	respItems := []map[string]interface{}{}
+	aces, err := parseSGACLContent(content)
+	if err != nil {
+		return respItems
+	}
+	for _, ace := range aces {
+		respItem := make(map[string]interface{})
+		respItem["ace"] = ace.String()
+		respItem["remark"] = ace.remark
+		respItem["action"] = ace.action
+		respItem["protocol"] = ace.protocol
+		respItem["source_port_operator"] = ""
+		respItem["source_ports"] = []string{}
+		if ace.sourcePort != nil {
+			respItem["source_port_operator"] = ace.sourcePort.operator
+			respItem["source_ports"] = ace.sourcePort.ports
+		}
+		respItem["destination_port_operator"] = ""
+		respItem["destination_ports"] = []string{}
+		if ace.destinationPort != nil {
+			respItem["destination_port_operator"] = ace.destinationPort.operator
+			respItem["destination_ports"] = ace.destinationPort.ports
+		}
+		respItem["icmp_type"] = ace.icmpType
+		respItem["icmp_code"] = ace.icmpCode
+		respItem["established"] = fmt.Sprintf("%t", ace.established)
+		respItem["log"] = fmt.Sprintf("%t", ace.log)
+		respItems = append(respItems, respItem)
+	}
+	return respItems
+}
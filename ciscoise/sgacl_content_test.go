@@ -0,0 +1,104 @@
+package ciscoise
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSGACLContentParse(t *testing.T) {
+	cases := map[string]struct {
+		Content  string
+		Expected []sgaclACE
+		Error    string
+	}{
+		"ports and log": {
+			Content: "permit tcp src range 1024 65535 dst eq 443 log",
+			Expected: []sgaclACE{{
+				action:          "permit",
+				protocol:        "tcp",
+				sourcePort:      &sgaclPortMatch{operator: "range", ports: []string{"1024", "65535"}},
+				destinationPort: &sgaclPortMatch{operator: "eq", ports: []string{"443"}},
+				log:             true,
+			}},
+		},
+		"icmp type and code": {
+			Content:  "deny icmp 3 4",
+			Expected: []sgaclACE{{action: "deny", protocol: "icmp", icmpType: "3", icmpCode: "4"}},
+		},
+		"remark and blank lines": {
+			Content: "\nremark  Allow   SSH\n\tpermit tcp dst eq ssh established\n",
+			Expected: []sgaclACE{
+				{remark: "Allow SSH"},
+				{action: "permit", protocol: "tcp", destinationPort: &sgaclPortMatch{operator: "eq", ports: []string{"ssh"}}, established: true},
+			},
+		},
+		"split port": {
+			Content: "permit ip\npermit tcp dst eq 44 3",
+			Error:   `line 2 "permit tcp dst eq 44 3": unexpected "3"`,
+		},
+		"reversed range": {
+			Content: "permit udp dst range 200 100",
+			Error:   `line 1 "permit udp dst range 200 100": invalid range 200 100 after dst, the first port is greater than the last`,
+		},
+	}
+	for tn, tc := range cases {
+		actual, err := parseSGACLContent(tc.Content)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Errorf("bad: %s, expected error %q, got %v", tn, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("bad: %s, unexpected error %v", tn, err)
+			continue
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("bad: %s, expected %+v, got %+v", tn, tc.Expected, actual)
+		}
+	}
+}
+
+func TestSGACLContentFormat(t *testing.T) {
+	content := "Remark web\r\n  PERMIT  TCP  DST EQ  WWW\npermit icmp Echo-Reply LOG\n\ndeny IP"
+	expected := "remark web\npermit tcp dst eq www\npermit icmp echo-reply log\ndeny ip"
+	if actual := normalizeSGACLContent(content); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+	if actual := normalizeSGACLContent(expected); actual != expected {
+		t.Errorf("expected normalized content to be kept, got %q", actual)
+	}
+	if actual := normalizeSGACLContent("permit tcp dst eq 44 3"); actual != "permit tcp dst eq 44 3" {
+		t.Errorf("expected invalid content to be kept, got %q", actual)
+	}
+}
+
+func TestSGACLContentFlatten(t *testing.T) {
+	aces := flattenSGACLContent("permit udp src gt 1023 dst eq domain log\ndeny ip")
+	if len(aces) != 2 {
+		t.Fatalf("expected 2 ACEs, got %d", len(aces))
+	}
+	expected := map[string]interface{}{
+		"ace":                       "permit udp src gt 1023 dst eq domain log",
+		"remark":                    "",
+		"action":                    "permit",
+		"protocol":                  "udp",
+		"source_port_operator":      "gt",
+		"source_ports":              []string{"1023"},
+		"destination_port_operator": "eq",
+		"destination_ports":         []string{"domain"},
+		"icmp_type":                 "",
+		"icmp_code":                 "",
+		"established":               "false",
+		"log":                       "true",
+	}
+	if !reflect.DeepEqual(aces[0], expected) {
+		t.Errorf("expected %v, got %v", expected, aces[0])
+	}
+	if aces[1]["ace"] != "deny ip" || aces[1]["log"] != "false" {
+		t.Errorf("expected deny ip, got %v", aces[1])
+	}
+	if aces := flattenSGACLContent("permit tcp dst eq 44 3"); len(aces) != 0 {
+		t.Errorf("expected no ACEs for invalid content, got %v", aces)
+	}
+}
@@ -0,0 +1,70 @@
+package ciscoise
+
+import (
+	"fmt"
+
+	isegosdk "github.com/kuba-mazurkiewicz/ciscoise-go-sdk/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// readSgtValues returns the values of all the security groups. The list only
+// has the names, so each security group is then read by id, as the ciscoise_sgt
+// data source does.
+func readSgtValues(m interface{}) (map[int]bool, error) {
+	client := m.(ClientConfig).Client
+	queryParams := isegosdk.GetSecurityGroupsQueryParams{Size: 100}
+	response, _, err := client.SecurityGroups.GetSecurityGroups(&queryParams)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the security groups: %v", err)
+	}
+	values := map[int]bool{}
+	for _, item := range getAllItemsSecurityGroupsGetSecurityGroups(m, response, &queryParams) {
+		getItem, _, err := client.SecurityGroups.GetSecurityGroupByID(item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the security group %s: %v", item.Name, err)
+		}
+		if getItem != nil && getItem.Sgt != nil && getItem.Sgt.Value != nil {
+			values[*getItem.Sgt.Value] = true
+		}
+	}
+	return values, nil
+}
+
+// lowestFreeSgtValue returns the lowest value from start to end, both
+// included, that is not used.
+func lowestFreeSgtValue(used map[int]bool, start int, end int) (int, error) {
+	if start > end {
+		return 0, fmt.Errorf("value_pool start %d is greater than end %d", start, end)
+	}
+	for value := start; value <= end; value++ {
+		if !used[value] {
+			return value, nil
+		}
+	}
+	return 0, fmt.Errorf("no free security group value in value_pool %d-%d", start, end)
+}
+
+// expandSgtValuePool returns the start and end of parameters.value_pool, ok
+// false when it is not set.
+func expandSgtValuePool(v interface{}) (int, int, bool) {
+	pools, ok := v.([]interface{})
+	if !ok || len(pools) == 0 {
+		return 0, 0, false
+	}
+	pool, ok := pools[0].(map[string]interface{})
+	if !ok {
+		return 0, 0, false
+	}
+	start, _ := pool["start"].(int)
+	end, _ := pool["end"].(int)
+	return start, end, true
+}
+
+// flattenSgtValuePool keeps the configured value_pool in the parameters read
+// from ISE, which do not have it.
+func flattenSgtValuePool(d *schema.ResourceData, item []map[string]interface{}) {
+	if len(item) > 0 {
+		item[0]["value_pool"] = d.Get("parameters.0.value_pool")
+	}
+}
@@ -0,0 +1,114 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLowestFreeSgtValue(t *testing.T) {
+	used := map[int]bool{10: true, 11: true, 13: true}
+	cases := map[string]struct {
+		Start    int
+		End      int
+		Expected int
+		Error    string
+	}{
+		"first gap":       {Start: 10, End: 20, Expected: 12},
+		"start free":      {Start: 14, End: 20, Expected: 14},
+		"single value":    {Start: 12, End: 12, Expected: 12},
+		"pool exhausted":  {Start: 10, End: 11, Error: "no free security group value in value_pool 10-11"},
+		"start after end": {Start: 20, End: 10, Error: "value_pool start 20 is greater than end 10"},
+	}
+	for tn, tc := range cases {
+		actual, err := lowestFreeSgtValue(used, tc.Start, tc.End)
+		if tc.Error != "" {
+			if err == nil || err.Error() != tc.Error {
+				t.Errorf("bad: %s, expected error %q, got %v", tn, tc.Error, err)
+			}
+			continue
+		}
+		if err != nil || actual != tc.Expected {
+			t.Errorf("bad: %s, expected %d, got %d (%v)", tn, tc.Expected, actual, err)
+		}
+	}
+}
+
+func TestResourceSgtCreateValuePool(t *testing.T) {
+	var lock sync.Mutex
+	values := map[string]int{"s1": 10, "s2": 11, "s3": 13}
+	m := newTestClientConfig(t, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		id := strings.TrimPrefix(r.URL.Path, "/ers/config/sgt")
+		switch {
+		case r.Method == http.MethodGet && id == "":
+			resources := []map[string]string{}
+			for id := range values {
+				resources = append(resources, map[string]string{"id": id, "name": id})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"SearchResult": map[string]interface{}{"total": len(resources), "resources": resources}})
+		case r.Method == http.MethodGet:
+			id = strings.TrimPrefix(id, "/")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"Sgt": map[string]interface{}{"id": id, "name": id, "value": values[id]}})
+		case r.Method == http.MethodPost:
+			var request struct {
+				Sgt struct {
+					Name  string `json:"name"`
+					Value int    `json:"value"`
+				} `json:"Sgt"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			for _, value := range values {
+				if value == request.Sgt.Value {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"ERSResponse":{"messages":[{"title":"Value already in use"}]}}`))
+					return
+				}
+			}
+			values[request.Sgt.Name] = request.Sgt.Value
+			w.Header().Set("Location", fmt.Sprintf("https://%s/ers/config/sgt/%s", r.Host, request.Sgt.Name))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var wg sync.WaitGroup
+	allocated := make([]int, 3)
+	for i, name := range []string{"Employees", "Guests", "Contractors"} {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			d := schema.TestResourceDataRaw(t, resourceSgt().Schema, map[string]interface{}{
+				"parameters": []interface{}{
+					map[string]interface{}{
+						"name":       name,
+						"value_pool": []interface{}{map[string]interface{}{"start": 10, "end": 20}},
+					},
+				},
+			})
+			if diags := resourceSgtCreate(context.Background(), d, m); diags.HasError() {
+				t.Errorf("unexpected error creating %s: %v", name, diags)
+				return
+			}
+			allocated[i] = d.Get("parameters.0.value").(int)
+			if pools := d.Get("parameters.0.value_pool").([]interface{}); len(pools) != 1 {
+				t.Errorf("expected value_pool to be kept for %s, got %v", name, pools)
+			}
+		}(i, name)
+	}
+	wg.Wait()
+	sort.Ints(allocated)
+	if fmt.Sprint(allocated) != "[12 14 15]" {
+		t.Errorf("expected values [12 14 15], got %v", allocated)
+	}
+}
@@ -12,3 +12,10 @@ const HOTPATCH_INSTALL_TIMEOUT_SLEEP = time.Duration(5) * time.Minute
 const HOTPATCH_ROLLBACK_TIMEOUT_SLEEP = time.Duration(3) * time.Minute
 const PATCH_INSTALL_TIMEOUT_SLEEP = time.Duration(5) * time.Minute
 const PATCH_ROLLBACK_TIMEOUT_SLEEP = time.Duration(3) * time.Minute
+
+const BULK_REQUEST_TIMEOUT = time.Duration(15) * time.Minute
+const BULK_REQUEST_STATUS_SLEEP = time.Duration(5) * time.Second
+
+const SG_MAPPING_DEPLOY_TIMEOUT = time.Duration(15) * time.Minute
+const SG_MAPPING_DEPLOY_STATUS_SLEEP = time.Duration(5) * time.Second
+const SG_MAPPING_DEPLOY_GRACE_PERIOD = time.Duration(30) * time.Second
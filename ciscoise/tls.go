@@ -0,0 +1,72 @@
+package ciscoise
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// readPEMSetting returns the PEM content of a provider setting, which holds
+// either the PEM content itself or the path of a file containing it.
+func readPEMSetting(name string, value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	content, err := ioutil.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %v", name, err)
+	}
+	return content, nil
+}
+
+// tlsConfig builds the TLS configuration from the provider settings.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.SSLVerify == "false",
+		ServerName:         c.TLSServerName,
+	}
+	if c.CACertificate != "" {
+		caCertificate, err := readPEMSetting("ca_certificate", c.CACertificate)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCertificate) {
+			return nil, fmt.Errorf("ca_certificate does not contain any PEM encoded certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.ClientCertificate != "" || c.ClientKey != "" {
+		if c.ClientCertificate == "" || c.ClientKey == "" {
+			return nil, fmt.Errorf("client_certificate and client_key must be set together")
+		}
+		clientCertificate, err := readPEMSetting("client_certificate", c.ClientCertificate)
+		if err != nil {
+			return nil, err
+		}
+		clientKey, err := readPEMSetting("client_key", c.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		certificate, err := tls.X509KeyPair(clientCertificate, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client_certificate or client_key: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+// personasTLSConfig returns the TLS configuration for the clients that talk to
+// every node of the deployment. tls_server_name is left out, since these
+// requests address the nodes by their own IP.
+func personasTLSConfig(tlsConfig *tls.Config) *tls.Config {
+	personasConfig := tlsConfig.Clone()
+	personasConfig.ServerName = ""
+	return personasConfig
+}
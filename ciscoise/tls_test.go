@@ -0,0 +1,99 @@
+package ciscoise
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func generateTestCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ise.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certificate), string(privateKey)
+}
+
+func TestTLSConfig(t *testing.T) {
+	certificate, privateKey := generateTestCertificate(t)
+	certificateFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := ioutil.WriteFile(certificateFile, []byte(certificate), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tests := []struct {
+		config      Config
+		errExpected bool
+	}{
+		{Config{SSLVerify: "true"}, false},
+		{Config{SSLVerify: "true", CACertificate: certificate, TLSServerName: "ise.example.com"}, false},
+		{Config{SSLVerify: "true", CACertificate: certificateFile}, false},
+		{Config{SSLVerify: "true", CACertificate: filepath.Join(t.TempDir(), "missing.pem")}, true},
+		{Config{SSLVerify: "true", CACertificate: "-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----"}, true},
+		{Config{SSLVerify: "true", ClientCertificate: certificate, ClientKey: privateKey}, false},
+		{Config{SSLVerify: "true", ClientCertificate: certificate}, true},
+		{Config{SSLVerify: "true", ClientCertificate: privateKey, ClientKey: certificate}, true},
+	}
+
+	for _, test := range tests {
+		out, err := test.config.tlsConfig()
+		if (err != nil) != test.errExpected {
+			t.Errorf("Mismatch on val %#v: expected error %#v but got %v", test.config, test.errExpected, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if out.InsecureSkipVerify {
+			t.Errorf("Mismatch on val %#v: expected certificate verification", test.config)
+		}
+		if out.ServerName != test.config.TLSServerName {
+			t.Errorf("Mismatch on val %#v: expected server name %#v but got %#v", test.config, test.config.TLSServerName, out.ServerName)
+		}
+		if (test.config.CACertificate != "") != (out.RootCAs != nil) {
+			t.Errorf("Mismatch on val %#v: unexpected root CAs %#v", test.config, out.RootCAs)
+		}
+		if (test.config.ClientCertificate != "") != (len(out.Certificates) == 1) {
+			t.Errorf("Mismatch on val %#v: unexpected client certificates %#v", test.config, out.Certificates)
+		}
+	}
+}
+
+func TestTLSConfigSSLVerify(t *testing.T) {
+	config := Config{SSLVerify: "false", TLSServerName: "ise.example.com"}
+	out, err := config.tlsConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !out.InsecureSkipVerify {
+		t.Errorf("Expected certificate verification to be disabled")
+	}
+	if personasTLSConfig(out).ServerName != "" {
+		t.Errorf("Expected the personas TLS configuration to leave out the server name")
+	}
+}
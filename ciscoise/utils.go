@@ -2,6 +2,8 @@ package ciscoise
 
 import (
 	"fmt"
+	"log"
+	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -10,7 +12,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func compareHotpatchName(old, new string) bool {
@@ -198,6 +202,7 @@ func diagError(summaryErr string, err error) diag.Diagnostic {
 
 func diagErrorWithResponse(summaryErr string, err error, restyResponse string) diag.Diagnostic {
 	diagErrResponse := diag.Diagnostic{Severity: diag.Error, Summary: summaryErr}
+	restyResponse = redactSecrets(restyResponse)
 	if err != nil {
 		diagErrResponse.Detail = fmt.Sprintf("%s\n%v", err.Error(), restyResponse)
 		return diagErrResponse
@@ -208,6 +213,7 @@ func diagErrorWithResponse(summaryErr string, err error, restyResponse string) d
 
 func diagErrorWithAltAndResponse(summaryErr string, err error, restyResponse string, summaryAlt string, detail string) diag.Diagnostic {
 	diagErrResponse := diag.Diagnostic{Severity: diag.Error}
+	restyResponse = redactSecrets(restyResponse)
 	if err != nil {
 		diagErrResponse.Summary = summaryErr
 		diagErrResponse.Detail = fmt.Sprintf("%s\n%v", err.Error(), restyResponse)
@@ -234,6 +240,31 @@ func diagErrorWithAlt(summaryErr string, err error, summaryAlt string, detail st
 	return diagErrResponse
 }
 
+// isNotFoundResponse reports whether ISE answered with a 404 for the request.
+func isNotFoundResponse(restyResp *resty.Response) bool {
+	return restyResp != nil && restyResp.StatusCode() == http.StatusNotFound
+}
+
+// diagReadError classifies a failed lookup inside a resource Read.
+// The resource is removed from state only when ISE reports it does not exist,
+// that is an empty result without error or a 404 response. Any other failure
+// (timeouts, authentication errors, 5xx) is returned as a diagnostic so
+// Terraform does not plan to recreate objects that still exist.
+func diagReadError(d *schema.ResourceData, summaryErr string, err error, restyResp *resty.Response) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err == nil || isNotFoundResponse(restyResp) {
+		log.Printf("[DEBUG] Resource %s not found, removing it from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+	if restyResp != nil {
+		diags = append(diags, diagErrorWithResponse(summaryErr, err, restyResp.String()))
+		return diags
+	}
+	diags = append(diags, diagError(summaryErr, err))
+	return diags
+}
+
 func getUnixTimeString() string {
 	return strconv.FormatInt(time.Now().Unix(), 10)
 }
@@ -1,8 +1,13 @@
 package ciscoise
 
 import (
+	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestUtilsCompareHotpatchName(t *testing.T) {
@@ -353,3 +358,33 @@ func TestUtilsRemoveParameters(t *testing.T) {
 		}
 	}
 }
+
+func TestUtilsDiagReadError(t *testing.T) {
+	restyResponse := func(statusCode int) *resty.Response {
+		return &resty.Response{RawResponse: &http.Response{StatusCode: statusCode}}
+	}
+	tests := []struct {
+		err          error
+		restyResp    *resty.Response
+		keepID       bool
+		diagExpected bool
+	}{
+		{nil, nil, false, false},
+		{fmt.Errorf("not found"), restyResponse(http.StatusNotFound), false, false},
+		{fmt.Errorf("unauthorized"), restyResponse(http.StatusUnauthorized), true, true},
+		{fmt.Errorf("internal server error"), restyResponse(http.StatusInternalServerError), true, true},
+		{fmt.Errorf("context deadline exceeded"), nil, true, true},
+	}
+
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId("1234")
+		diags := diagReadError(d, "Failure when executing GetSecurityGroupByID", test.err, test.restyResp)
+		if diags.HasError() != test.diagExpected {
+			t.Errorf("Mismatch on val %#v: expected error %#v but got %#v", test.err, test.diagExpected, diags)
+		}
+		if (d.Id() != "") != test.keepID {
+			t.Errorf("Mismatch on val %#v: expected to keep id %#v but got id %#v", test.err, test.keepID, d.Id())
+		}
+	}
+}
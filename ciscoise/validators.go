@@ -1,8 +1,10 @@
 package ciscoise
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -80,3 +82,81 @@ func validateStringMatchesPattern(pattern string) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+func validateConditionJSON() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "" {
+			return
+		}
+		var condition interface{}
+		if err := json.Unmarshal([]byte(value), &condition); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not valid JSON: %v", k, err))
+			return
+		}
+		if err := validateConditionTree(condition, "condition"); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid condition: %v", k, err))
+		}
+		return
+	}
+}
+
+func validateConditionExpression() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "" {
+			return
+		}
+		if _, err := parseConditionExpression(value); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid condition expression: %v", k, err))
+		}
+		return
+	}
+}
+
+func validateSGACLContent() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "" {
+			return
+		}
+		if _, err := parseSGACLContent(value); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid SGACL: %v", k, err))
+		}
+		return
+	}
+}
+
+// validateConditionTree checks that every node of a condition tree has a
+// conditionType, and that the AND and OR blocks have children.
+func validateConditionTree(v interface{}, path string) error {
+	condition, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s must be an object", path)
+	}
+	conditionType, _ := condition["conditionType"].(string)
+	if conditionType == "" {
+		return fmt.Errorf("%s has no conditionType", path)
+	}
+	children, hasChildren := condition["children"]
+	isBlock := strings.HasSuffix(conditionType, "AndBlock") || strings.HasSuffix(conditionType, "OrBlock")
+	if !hasChildren || children == nil {
+		if isBlock {
+			return fmt.Errorf("%s is a %s without children", path, conditionType)
+		}
+		return nil
+	}
+	vChildren, ok := children.([]interface{})
+	if !ok {
+		return fmt.Errorf("%s.children must be a list", path)
+	}
+	if isBlock && len(vChildren) == 0 {
+		return fmt.Errorf("%s is a %s without children", path, conditionType)
+	}
+	for i, child := range vChildren {
+		if err := validateConditionTree(child, fmt.Sprintf("%s.children[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -89,3 +89,96 @@ func TestValidatorsValidateStringMatchesPattern(t *testing.T) {
 		t.Fatalf("%q should match the pattern", v)
 	}
 }
+
+func TestValidatorsValidateConditionJSON(t *testing.T) {
+	validStrings := []string{
+		"",
+		testConditionJSON,
+		`{"conditionType":"ConditionReference","id":"c1"}`,
+	}
+	invalidStrings := []string{
+		`{"conditionType":`,
+		`["ConditionReference"]`,
+		`{"id":"c1"}`,
+		`{"conditionType":"ConditionAndBlock"}`,
+		`{"conditionType":"ConditionOrBlock","children":[]}`,
+		`{"conditionType":"ConditionOrBlock","children":{"conditionType":"ConditionReference"}}`,
+		`{"conditionType":"ConditionOrBlock","children":[{"conditionType":"ConditionAndBlock","children":[{"id":"c1"}]}]}`,
+	}
+	for _, v := range validStrings {
+		if _, errors := validateConditionJSON()(v, "condition_json"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid condition: %q", v, errors)
+		}
+	}
+	for _, v := range invalidStrings {
+		if _, errors := validateConditionJSON()(v, "condition_json"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid condition", v)
+		}
+	}
+}
+
+func TestValidatorsValidateConditionExpression(t *testing.T) {
+	validStrings := []string{
+		"",
+		testConditionExpression,
+		`ref("Wired_802.1X")`,
+		`Radius:Service-Type in "Login, Framed"`,
+	}
+	invalidStrings := []string{
+		`Radius:NAS-Port-Type EQUALS`,
+		`Radius:NAS-Port-Type IS "Ethernet"`,
+		`Radius NAS-Port-Type EQUALS "Ethernet"`,
+		`(ref("Wired_802.1X")`,
+		`ref("Wired_802.1X") AND`,
+		`ref("Wired_802.1X) OR ref("Wireless_802.1X")`,
+	}
+	for _, v := range validStrings {
+		if _, errors := validateConditionExpression()(v, "condition_expression"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid condition expression: %q", v, errors)
+		}
+	}
+	for _, v := range invalidStrings {
+		if _, errors := validateConditionExpression()(v, "condition_expression"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid condition expression", v)
+		}
+	}
+}
+
+func TestValidatorsValidateSGACLContent(t *testing.T) {
+	validStrings := []string{
+		"",
+		"permit ip",
+		"deny ip log",
+		"permit tcp dst eq 443\npermit udp src gt 1023 dst eq domain\ndeny ip",
+		"Permit TCP Src Range 1024 65535 Dst Eq 22 Log",
+		"permit tcp established",
+		"permit icmp echo-reply\npermit icmp 3 4 log",
+		"remark allow web\r\npermit tcp dst eq www\n\n",
+		"permit 47",
+	}
+	invalidStrings := []string{
+		"permit tcp dst eq 44 3",
+		"allow ip",
+		"permit",
+		"permit ipx",
+		"permit ip dst eq 80",
+		"permit tcp dst eq 70000",
+		"permit tcp dst range 443",
+		"permit tcp dst range 443 80",
+		"permit tcp dst eq htps",
+		"permit tcp dst eq 80 src eq 1024",
+		"permit udp established",
+		"permit icmp echo-replies",
+		"permit ip log log",
+	}
+	for _, v := range validStrings {
+		if _, errors := validateSGACLContent()(v, "aclcontent"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid SGACL: %q", v, errors)
+		}
+	}
+	for _, v := range invalidStrings {
+		if _, errors := validateSGACLContent()(v, "aclcontent"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SGACL", v)
+		}
+	}
+}
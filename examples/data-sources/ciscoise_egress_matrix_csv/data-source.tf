@@ -0,0 +1,8 @@
+
+data "ciscoise_egress_matrix_csv" "example" {
+  provider = ciscoise
+}
+
+output "ciscoise_egress_matrix_csv_example" {
+  value = data.ciscoise_egress_matrix_csv.example.csv
+}
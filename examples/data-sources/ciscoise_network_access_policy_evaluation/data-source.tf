@@ -0,0 +1,14 @@
+
+data "ciscoise_network_access_policy_evaluation" "example" {
+  provider = ciscoise
+  attributes = {
+    "Radius:NAS-Port-Type"     = "Wireless - IEEE 802.11"
+    "Radius:Called-Station-ID" = "00-11-22-33-44-55:Corp"
+    "EndPoints:LogicalProfile" = "Printers"
+    "IdentityGroup:Name"       = "Endpoint Identity Groups:Profiled"
+  }
+}
+
+output "ciscoise_network_access_policy_evaluation_example" {
+  value = data.ciscoise_network_access_policy_evaluation.example.item
+}
@@ -0,0 +1,9 @@
+
+data "ciscoise_network_access_policy_lint" "example" {
+  provider  = ciscoise
+  policy_id = "string"
+}
+
+output "ciscoise_network_access_policy_lint_example" {
+  value = data.ciscoise_network_access_policy_lint.example.items
+}
@@ -0,0 +1,10 @@
+data "ciscoise_policy_hitcount_report" "example" {
+  provider   = ciscoise
+  domain     = "network_access"
+  max_hits   = 0
+  last_reset = ciscoise_network_access_policy_set_reset_hitcount.example.last_updated
+}
+
+output "ciscoise_policy_hitcount_report_example" {
+  value = data.ciscoise_policy_hitcount_report.example.items
+}
@@ -0,0 +1,8 @@
+
+data "ciscoise_policy_snapshot" "example" {
+  provider = ciscoise
+}
+
+output "ciscoise_policy_snapshot_example" {
+  value = data.ciscoise_policy_snapshot.example.checksum
+}
@@ -0,0 +1,14 @@
+
+data "ciscoise_policy_snapshot" "current" {
+  provider = ciscoise
+}
+
+data "ciscoise_policy_snapshot_diff" "example" {
+  provider     = ciscoise
+  old_snapshot = file("baseline.json")
+  new_snapshot = data.ciscoise_policy_snapshot.current.snapshot
+}
+
+output "ciscoise_policy_snapshot_diff_example" {
+  value = data.ciscoise_policy_snapshot_diff.example.items
+}
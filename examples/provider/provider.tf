@@ -12,6 +12,10 @@ provider "ciscoise" {
   base_url = "https://172.168.196.2"
   # it can be set using the environment variable ISE_BASE_URL
 
+  #  Secondary PAN base URLs, used in order when base_url does not answer
+  fallback_base_urls = ["https://172.168.196.3"]
+  # it can be set using the comma-separated environment variable ISE_FALLBACK_BASE_URLS
+
   # Boolean to enable debugging
   debug = "false"
   # it can be set using the environment variable ISE_DEBUG
@@ -20,6 +24,19 @@ provider "ciscoise" {
   ssl_verify = "false"
   # it can be set using the environment variable ISE_SSL_VERIFY
 
+  # CA certificate used to verify ISE, PEM content or file path
+  ca_certificate = "/etc/ssl/certs/internal-ca.pem"
+  # it can be set using the environment variable ISE_CA_CERTIFICATE
+
+  # Client certificate and key for mutual TLS, PEM content or file paths
+  # client_certificate = "/etc/ssl/certs/terraform.pem"
+  # client_key         = "/etc/ssl/private/terraform.key"
+  # they can be set using the environment variables ISE_CLIENT_CERTIFICATE and ISE_CLIENT_KEY
+
+  # Server name expected in the ISE certificate, when it differs from the base_url host
+  # tls_server_name = "ise.example.com"
+  # it can be set using the environment variable ISE_TLS_SERVER_NAME
+
   # Boolean to enable or disable the usage of the ISE's API Gateway
   use_api_gateway = "false"
   # it can be set using the environment variable ISE_USE_API_GATEWAY
@@ -32,6 +49,19 @@ provider "ciscoise" {
   single_request_timeout = 60
   # it can be set using the environment variable ISE_SINGLE_REQUEST_TIMEOUT
 
+  # Maximum number of retries for transient failures (429, 502, 503, 504, connection errors)
+  max_retries = 3
+  # it can be set using the environment variable ISE_MAX_RETRIES
+
+  # Minimum and maximum wait (in seconds) between retries
+  retry_min_wait = 1
+  retry_max_wait = 30
+  # they can be set using the environment variables ISE_RETRY_MIN_WAIT and ISE_RETRY_MAX_WAIT
+
+  # Maximum number of requests sent to ISE at the same time, 0 means no limit
+  max_concurrent_requests = 0
+  # it can be set using the environment variable ISE_MAX_CONCURRENT_REQUESTS
+
   # Boolean to enable or disable autoimport on resources
   enable_auto_import = "false"
   # it can be set using the environment variable ISE_ENABLE_AUTO_IMPORT
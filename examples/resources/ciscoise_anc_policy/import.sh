@@ -1 +1,3 @@
-terraform import ciscoise_anc_policy.example "id:=string\name:=string"
\ No newline at end of file
+terraform import ciscoise_anc_policy.example "id:=string\name:=string"
+terraform import ciscoise_anc_policy.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_anc_policy.example "name:string"
\ No newline at end of file
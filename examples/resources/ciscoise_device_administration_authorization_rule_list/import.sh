@@ -0,0 +1 @@
+terraform import ciscoise_device_administration_authorization_rule_list.example "policy_id"
@@ -0,0 +1,26 @@
+resource "ciscoise_device_administration_authorization_rule_list" "example" {
+  provider = ciscoise
+  parameters {
+
+    policy_id = "string"
+    rules {
+
+      name                 = "Network_Admins"
+      state                = "enabled"
+      condition_expression = "\"Network Access\":UserName EQUALS \"admin\" OR ref(\"Network_Admins\")"
+      commands             = ["PermitAllCommands"]
+      profile              = "Default Shell Profile"
+    }
+    rules {
+
+      name                 = "Helpdesk"
+      condition_expression = "ref(\"Helpdesk\")"
+      commands             = ["DenyAllCommands"]
+      profile              = "Deny All Shell Profile"
+    }
+  }
+}
+
+output "ciscoise_device_administration_authorization_rule_list_example" {
+  value = ciscoise_device_administration_authorization_rule_list.example
+}
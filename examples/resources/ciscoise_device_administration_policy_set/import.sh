@@ -1,2 +1,4 @@
 terraform import ciscoise_device_administration_policy_set.example "id:=string"
-terraform import ciscoise_device_administration_policy_set.example "name:=string"
\ No newline at end of file
+terraform import ciscoise_device_administration_policy_set.example "name:=string"
+terraform import ciscoise_device_administration_policy_set.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_device_administration_policy_set.example "name:string"
\ No newline at end of file
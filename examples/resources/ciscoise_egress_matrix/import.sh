@@ -0,0 +1 @@
+terraform import ciscoise_egress_matrix.example "egress_matrix"
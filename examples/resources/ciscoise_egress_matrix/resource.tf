@@ -0,0 +1,35 @@
+resource "ciscoise_egress_matrix" "example" {
+  provider = ciscoise
+  parameters {
+
+    exclusive = "false"
+    cells {
+
+      source_sgt_name      = "Employees"
+      destination_sgt_name = "Servers"
+      sgacl_names          = ["Permit_Web", "Permit_SSH"]
+      default_rule         = "DENY_IP"
+      matrix_cell_status   = "ENABLED"
+    }
+    cells {
+
+      source_sgt_name      = "Guests"
+      destination_sgt_name = "Servers"
+      default_rule         = "DENY_IP"
+      description          = "Guests never reach the servers"
+    }
+  }
+}
+
+output "ciscoise_egress_matrix_example" {
+  value = ciscoise_egress_matrix.example
+}
+
+resource "ciscoise_egress_matrix" "from_csv" {
+  provider = ciscoise
+  parameters {
+
+    exclusive = "false"
+    cells_csv = file("${path.module}/egress_policy.csv")
+  }
+}
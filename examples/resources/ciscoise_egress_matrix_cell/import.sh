@@ -1,2 +1,4 @@
 terraform import ciscoise_egress_matrix_cell.example "id:=string"
-terraform import ciscoise_egress_matrix_cell.example "name:=string"
\ No newline at end of file
+terraform import ciscoise_egress_matrix_cell.example "name:=string"
+terraform import ciscoise_egress_matrix_cell.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_egress_matrix_cell.example "name:string"
\ No newline at end of file
@@ -1 +1,3 @@
-terraform import ciscoise_network_access_authentication_rules.example "id:=string\policy_id:=string"
\ No newline at end of file
+terraform import ciscoise_network_access_authentication_rules.example "id:=string\policy_id:=string"
+terraform import ciscoise_network_access_authentication_rules.example "policy_id/rule_id"
+terraform import ciscoise_network_access_authentication_rules.example "policy_id/name:string"
\ No newline at end of file
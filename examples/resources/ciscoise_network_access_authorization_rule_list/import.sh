@@ -0,0 +1 @@
+terraform import ciscoise_network_access_authorization_rule_list.example "policy_id"
@@ -0,0 +1,25 @@
+resource "ciscoise_network_access_authorization_rule_list" "example" {
+  provider = ciscoise
+  parameters {
+
+    policy_id = "string"
+    rules {
+
+      name                 = "Wireless_Employees"
+      state                = "enabled"
+      condition_expression = "Radius:NAS-Port-Type EQUALS \"Wireless - IEEE 802.11\" AND ref(\"Employees\")"
+      profile              = ["PermitAccess"]
+      security_group       = "Employees"
+    }
+    rules {
+
+      name                 = "Wired_Guests"
+      condition_expression = "NOT ref(\"Wireless_802.1X\")"
+      profile              = ["Guest"]
+    }
+  }
+}
+
+output "ciscoise_network_access_authorization_rule_list_example" {
+  value = ciscoise_network_access_authorization_rule_list.example
+}
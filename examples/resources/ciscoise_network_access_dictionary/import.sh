@@ -1 +1,3 @@
-terraform import ciscoise_network_access_dictionary.example "name:=string"
\ No newline at end of file
+terraform import ciscoise_network_access_dictionary.example "name:=string"
+terraform import ciscoise_network_access_dictionary.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_network_access_dictionary.example "name:string"
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import ciscoise_network_access_policy_bundle.example "policy_id"
@@ -0,0 +1,37 @@
+resource "ciscoise_network_access_policy_bundle" "example" {
+  provider = ciscoise
+  parameters {
+
+    name           = "Wired"
+    description    = "Wired 802.1X and MAB"
+    service_name   = "Default Network Access"
+    state          = "enabled"
+    condition_name = "Wired_802.1X"
+    authentication_rules {
+
+      name                 = "Dot1X"
+      condition_expression = "Network Access:EapAuthentication EQUALS \"EAP-TLS\""
+      identity_source_name = "All_User_ID_Stores"
+      if_auth_fail         = "REJECT"
+      if_process_fail      = "DROP"
+      if_user_not_found    = "REJECT"
+    }
+    local_exception_rules {
+
+      name           = "Quarantine"
+      condition_name = "Quarantined_Endpoints"
+      profile        = ["DenyAccess"]
+    }
+    authorization_rules {
+
+      name                 = "Employees"
+      condition_expression = "ref(\"Employees\")"
+      profile              = ["PermitAccess"]
+      security_group       = "Employees"
+    }
+  }
+}
+
+output "ciscoise_network_access_policy_bundle_example" {
+  value = ciscoise_network_access_policy_bundle.example
+}
@@ -4,7 +4,27 @@ resource "ciscoise_network_device_bulk_request" "example" {
     create_before_destroy = true
   }
   parameters {
-    operation_type      = "string"
-    resource_media_type = "string"
+    operation_type      = "create"
+    resource_media_type = "vnd.com.cisco.ise.network.networkdevice.1.1+xml"
+    resources {
+      name        = "switch-01"
+      description = "Access switch 01"
+      network_device_iplist {
+        ipaddress = "10.0.0.1"
+        mask      = 32
+      }
+      authentication_settings {
+        network_protocol     = "RADIUS"
+        radius_shared_secret = "string"
+      }
+    }
+    resources {
+      name        = "switch-02"
+      description = "Access switch 02"
+      network_device_iplist {
+        ipaddress = "10.0.0.2"
+        mask      = 32
+      }
+    }
   }
-}
\ No newline at end of file
+}
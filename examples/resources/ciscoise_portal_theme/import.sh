@@ -1 +1,3 @@
-terraform import ciscoise_portal_theme.example "id:=string"
\ No newline at end of file
+terraform import ciscoise_portal_theme.example "id:=string"
+terraform import ciscoise_portal_theme.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_portal_theme.example "name:string"
\ No newline at end of file
@@ -1,2 +1,4 @@
 terraform import ciscoise_self_registered_portal.example "id:=string"
-terraform import ciscoise_self_registered_portal.example "name:=string"
\ No newline at end of file
+terraform import ciscoise_self_registered_portal.example "name:=string"
+terraform import ciscoise_self_registered_portal.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_self_registered_portal.example "name:string"
\ No newline at end of file
@@ -3,7 +3,11 @@ resource "ciscoise_sg_acl" "example" {
   provider = ciscoise
   parameters {
 
-    aclcontent    = "string"
+    aclcontent    = <<-EOT
+      permit tcp dst eq 443
+      permit udp src gt 1023 dst eq domain
+      deny ip log
+    EOT
     description   = "string"
     generation_id = "string"
     id            = "string"
@@ -1,2 +1,4 @@
 terraform import ciscoise_sg_mapping_group.example "id:=string"
-terraform import ciscoise_sg_mapping_group.example "name:=string"
\ No newline at end of file
+terraform import ciscoise_sg_mapping_group.example "name:=string"
+terraform import ciscoise_sg_mapping_group.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_sg_mapping_group.example "name:string"
\ No newline at end of file
@@ -1,2 +1,4 @@
 terraform import ciscoise_sg_to_vn_to_vlan.example "id:=string"
-terraform import ciscoise_sg_to_vn_to_vlan.example "name:=string"
\ No newline at end of file
+terraform import ciscoise_sg_to_vn_to_vlan.example "name:=string"
+terraform import ciscoise_sg_to_vn_to_vlan.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_sg_to_vn_to_vlan.example "name:string"
\ No newline at end of file
@@ -15,4 +15,16 @@ resource "ciscoise_sgt" "example" {
 
 output "ciscoise_sgt_example" {
   value = ciscoise_sgt.example
+}
+
+resource "ciscoise_sgt" "from_pool" {
+  provider = ciscoise
+  parameters {
+
+    name = "Contractors"
+    value_pool {
+      start = 1000
+      end   = 1999
+    }
+  }
 }
\ No newline at end of file
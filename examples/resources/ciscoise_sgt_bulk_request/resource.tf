@@ -4,7 +4,13 @@ resource "ciscoise_sgt_bulk_request" "example" {
     create_before_destroy = true
   }
   parameters {
-    operation_type      = "string"
-    resource_media_type = "string"
+    operation_type      = "delete"
+    resource_media_type = "vnd.com.cisco.ise.trustsec.sgt.1.0+xml"
+    resources {
+      id = "string"
+    }
+    resources {
+      id = "string"
+    }
   }
-}
\ No newline at end of file
+}
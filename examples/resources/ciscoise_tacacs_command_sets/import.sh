@@ -1 +1,3 @@
-terraform import ciscoise_tacacs_command_sets.example "id:=string\name:=string"
\ No newline at end of file
+terraform import ciscoise_tacacs_command_sets.example "id:=string\name:=string"
+terraform import ciscoise_tacacs_command_sets.example "00000000-0000-0000-0000-000000000000"
+terraform import ciscoise_tacacs_command_sets.example "name:string"
\ No newline at end of file